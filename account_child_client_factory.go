@@ -0,0 +1,367 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultChildAccountTokenRefreshWindow is the default duration before a child account
+// proxy token's expiry at which it will be replaced.
+const DefaultChildAccountTokenRefreshWindow = 5 * time.Minute
+
+var errChildAccountFactoryClosed = errors.New("child account client factory is closed")
+
+// ChildAccountClientFactoryOptions configures a ChildAccountClientFactory.
+type ChildAccountClientFactoryOptions struct {
+	// RefreshWindow is the duration before a proxy token's expiry at which it will be replaced.
+	// Defaults to DefaultChildAccountTokenRefreshWindow.
+	RefreshWindow time.Duration
+}
+
+// ChildAccountClientFactory hands out Clients authenticated against child accounts
+// of the parent account.
+//
+// Proxy tokens are created on demand using CreateChildAccountToken(...), cached and
+// replaced shortly before they expire. As requests using a replaced token may still be in flight,
+// tokens are only revoked when their child account's Client is revoked or the factory is closed.
+// NOTE: Parent/Child related features may not be generally available.
+type ChildAccountClientFactory struct {
+	parent        *Client
+	refreshWindow time.Duration
+
+	lock    sync.Mutex
+	clients map[string]*childAccountClient
+	closed  bool
+}
+
+type childAccountClient struct {
+	client   *Client
+	provider *childAccountCredentialProvider
+}
+
+// NewChildAccountClientFactory creates a new ChildAccountClientFactory using
+// this client as the parent account client.
+// NOTE: Parent/Child related features may not be generally available.
+func (c *Client) NewChildAccountClientFactory(opts *ChildAccountClientFactoryOptions) *ChildAccountClientFactory {
+	result := &ChildAccountClientFactory{
+		parent:        c,
+		refreshWindow: DefaultChildAccountTokenRefreshWindow,
+		clients:       make(map[string]*childAccountClient),
+	}
+
+	if opts != nil && opts.RefreshWindow > 0 {
+		result.refreshWindow = opts.RefreshWindow
+	}
+
+	return result
+}
+
+// Client returns a Client authenticated against the child account with the given EUUID.
+// The same Client is returned for subsequent calls with the same EUUID.
+func (f *ChildAccountClientFactory) Client(ctx context.Context, euuid string) (*Client, error) {
+	f.lock.Lock()
+
+	if f.closed {
+		f.lock.Unlock()
+		return nil, errChildAccountFactoryClosed
+	}
+
+	if existing, ok := f.clients[euuid]; ok {
+		f.lock.Unlock()
+		return existing.client, nil
+	}
+
+	f.lock.Unlock()
+
+	provider := &childAccountCredentialProvider{factory: f, euuid: euuid}
+
+	// Create the initial token eagerly to validate the child account.
+	// The factory isn't locked while the token is created so that other child accounts aren't blocked.
+	if _, err := provider.Retrieve(ctx); err != nil {
+		return nil, err
+	}
+
+	client := f.parent.cloneClient()
+	client.SetCredentialProvider(provider)
+
+	f.lock.Lock()
+
+	existing, ok := f.clients[euuid]
+	if closed := f.closed; closed || ok {
+		f.lock.Unlock()
+
+		// The factory was closed or another caller created a Client concurrently,
+		// so the new token is no longer needed
+		if err := provider.revoke(ctx); err != nil {
+			f.warnf("Failed to revoke unused token for child account %s: %s", euuid, err)
+		}
+
+		if closed {
+			return nil, errChildAccountFactoryClosed
+		}
+
+		return existing.client, nil
+	}
+
+	f.clients[euuid] = &childAccountClient{client: client, provider: provider}
+	f.lock.Unlock()
+
+	return client, nil
+}
+
+// ForEach calls fn with a Client for each child account of the parent account,
+// stopping at the first error returned by fn.
+func (f *ChildAccountClientFactory) ForEach(
+	ctx context.Context,
+	opts *ListOptions,
+	fn func(ctx context.Context, child ChildAccount, client *Client) error,
+) error {
+	children, err := f.parent.ListChildAccounts(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("failed to list child accounts: %w", err)
+	}
+
+	for _, child := range children {
+		client, err := f.Client(ctx, child.EUUID)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(ctx, child, client); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MultiClient creates a MultiClient targeting all child accounts of the parent account,
+// using Clients managed by this factory. Each target is named using the child account's EUUID.
+func (f *ChildAccountClientFactory) MultiClient(ctx context.Context, opts *ListOptions) (*MultiClient, error) {
+	result := &MultiClient{}
+
+	err := f.ForEach(ctx, opts, func(_ context.Context, child ChildAccount, client *Client) error {
+		result.Targets = append(result.Targets, MultiClientTarget{Name: child.EUUID, Client: client})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Revoke revokes the proxy tokens for the child account with the given EUUID
+// and removes its Client from the factory. Requests made using the removed Client
+// fail, and subsequent calls to Client(...) create a new Client.
+func (f *ChildAccountClientFactory) Revoke(ctx context.Context, euuid string) error {
+	f.lock.Lock()
+	entry, ok := f.clients[euuid]
+	delete(f.clients, euuid)
+	f.lock.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	return entry.provider.revoke(ctx)
+}
+
+// Close revokes the proxy tokens of all child account Clients created by the factory.
+// Requests made using Clients created by the factory fail once it is closed.
+func (f *ChildAccountClientFactory) Close(ctx context.Context) error {
+	f.lock.Lock()
+	clients := f.clients
+	f.clients = make(map[string]*childAccountClient)
+	f.closed = true
+	f.lock.Unlock()
+
+	euuids := make([]string, 0, len(clients))
+	for euuid := range clients {
+		euuids = append(euuids, euuid)
+	}
+
+	sort.Strings(euuids)
+
+	errs := make([]error, 0)
+
+	for _, euuid := range euuids {
+		if err := clients[euuid].provider.revoke(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (f *ChildAccountClientFactory) warnf(format string, v ...any) {
+	if f.parent.logger != nil {
+		f.parent.logger.Warnf(format, v...)
+	}
+}
+
+func (f *ChildAccountClientFactory) isClosed() bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.closed
+}
+
+// childAccountCredentialProvider is a CredentialProvider resolving
+// proxy tokens for a single child account.
+type childAccountCredentialProvider struct {
+	factory *ChildAccountClientFactory
+	euuid   string
+
+	lock  sync.Mutex
+	token *ChildAccountToken
+
+	// replaced holds unexpired tokens which have been replaced by a newer token.
+	// They are revoked along with the current token, as requests using them may still be in flight.
+	replaced []*ChildAccountToken
+
+	// revoked is set once the provider's token has been revoked,
+	// after which no new tokens will be created
+	revoked bool
+
+	inflight inflightGroup
+}
+
+// Retrieve implements CredentialProvider.
+//
+// The provider isn't locked while a token is created, so callers aren't blocked by the API.
+// Concurrent callers without a valid token share a single token creation.
+func (p *childAccountCredentialProvider) Retrieve(ctx context.Context) (*Credentials, error) {
+	p.lock.Lock()
+
+	if p.revoked || p.factory.isClosed() {
+		p.lock.Unlock()
+		return nil, fmt.Errorf("failed to create token for child account %s: %w", p.euuid, errChildAccountFactoryClosed)
+	}
+
+	if p.token != nil && !p.expiresSoon() {
+		result := p.credentials(p.token)
+		p.lock.Unlock()
+
+		return result, nil
+	}
+
+	p.lock.Unlock()
+
+	created, err := p.inflight.do(ctx, "", func(ctx context.Context) (any, error) {
+		token, err := p.factory.parent.CreateChildAccountToken(ctx, p.euuid)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create token for child account %s: %w", p.euuid, err)
+		}
+
+		p.lock.Lock()
+
+		revoked := p.revoked
+		if !revoked {
+			p.replaceToken(token)
+		}
+
+		p.lock.Unlock()
+
+		if revoked {
+			// The provider was revoked while the token was created, so the token is no longer needed
+			if err := p.revokeToken(ctx, token); err != nil {
+				p.factory.warnf("Failed to revoke unused token for child account %s: %s", p.euuid, err)
+			}
+
+			return nil, fmt.Errorf("failed to create token for child account %s: %w", p.euuid, errChildAccountFactoryClosed)
+		}
+
+		return token, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.credentials(created.(*ChildAccountToken)), nil
+}
+
+// replaceToken sets the provider's current token, keeping the replaced token to be revoked later.
+// The provider must be locked by the caller.
+func (p *childAccountCredentialProvider) replaceToken(token *ChildAccountToken) {
+	// Expired tokens don't need to be revoked
+	p.replaced = slices.DeleteFunc(p.replaced, func(replaced *ChildAccountToken) bool {
+		return replaced.Expiry != nil && time.Now().After(*replaced.Expiry)
+	})
+
+	if p.token != nil {
+		p.replaced = append(p.replaced, p.token)
+	}
+
+	p.token = token
+}
+
+func (p *childAccountCredentialProvider) credentials(token *ChildAccountToken) *Credentials {
+	result := &Credentials{Token: token.Token, Source: "child-account:" + p.euuid}
+	if token.Expiry != nil {
+		result.Expiry = *token.Expiry
+	}
+
+	return result
+}
+
+// Invalidate implements CredentialInvalidator.
+func (p *childAccountCredentialProvider) Invalidate() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	// The token has been rejected by the API so there is no need to revoke it
+	p.token = nil
+}
+
+func (p *childAccountCredentialProvider) expiresSoon() bool {
+	if p.token.Expiry == nil {
+		return false
+	}
+
+	return time.Until(*p.token.Expiry) <= p.factory.refreshWindow
+}
+
+func (p *childAccountCredentialProvider) revoke(ctx context.Context) error {
+	p.lock.Lock()
+
+	p.revoked = true
+
+	tokens := p.replaced
+	if p.token != nil {
+		tokens = append(tokens, p.token)
+	}
+
+	p.token = nil
+	p.replaced = nil
+
+	p.lock.Unlock()
+
+	// The tokens are revoked without holding the lock so that callers aren't blocked by the API
+	errs := make([]error, 0)
+
+	for _, token := range tokens {
+		if err := p.revokeToken(ctx, token); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// revokeToken revokes the given token using a client authenticated with the token itself.
+func (p *childAccountCredentialProvider) revokeToken(ctx context.Context, token *ChildAccountToken) error {
+	client := p.factory.parent.cloneClient()
+	client.SetToken(token.Token)
+
+	if err := client.DeleteToken(ctx, token.ID); err != nil && !IsNotFound(err) {
+		return fmt.Errorf("failed to revoke token %d for child account %s: %w", token.ID, p.euuid, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,264 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestChildAccountServer creates a stand-in API server issuing proxy tokens
+// for child accounts, recording the IDs of revoked tokens.
+// The first token issued expires within the default refresh window.
+func newTestChildAccountServer(t *testing.T) (*httptest.Server, func() []int) {
+	t.Helper()
+
+	var (
+		lock    sync.Mutex
+		issued  int
+		revoked []int
+		mux     = http.NewServeMux()
+	)
+
+	mux.HandleFunc("GET /v4/account/child-accounts", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": [{"euuid": "child-a"}, {"euuid": "child-b"}], "page": 1, "pages": 1, "results": 2}`))
+	})
+
+	mux.HandleFunc("POST /v4/account/child-accounts/{euuid}/token", func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		issued++
+		id := issued
+		lock.Unlock()
+
+		expiry := time.Now().UTC().Add(time.Hour)
+		if id == 1 {
+			expiry = time.Now().UTC().Add(time.Minute)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id":     id,
+			"token":  fmt.Sprintf("%s-%d", r.PathValue("euuid"), id),
+			"expiry": expiry.Format("2006-01-02T15:04:05"),
+		})
+	})
+
+	mux.HandleFunc("DELETE /v4/profile/tokens/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.Atoi(r.PathValue("id"))
+		require.NoError(t, err)
+
+		// Tokens must be revoked using the token itself
+		require.True(t, strings.HasSuffix(r.Header.Get("Authorization"), fmt.Sprintf("-%d", id)))
+
+		lock.Lock()
+		revoked = append(revoked, id)
+		lock.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	mux.HandleFunc("GET /v4/profile", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"username": strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "),
+		})
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server, func() []int {
+		lock.Lock()
+		defer lock.Unlock()
+
+		return append([]int{}, revoked...)
+	}
+}
+
+func TestChildAccountClientFactory_Rotation(t *testing.T) {
+	server, revoked := newTestChildAccountServer(t)
+
+	parent := newTestClient(t, server.Client())
+	parent.SetBaseURL(server.URL)
+	parent.SetToken("parent")
+
+	factory := parent.NewChildAccountClientFactory(nil)
+
+	client, err := factory.Client(context.Background(), "child-a")
+	require.NoError(t, err)
+
+	// The initial token expires within the refresh window, so it is replaced.
+	// It isn't revoked yet as requests using it may still be in flight.
+	profile, err := client.GetProfile(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "child-a-2", profile.Username)
+	require.Empty(t, revoked())
+
+	// The replacement token is reused until it nears expiry
+	profile, err = client.GetProfile(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "child-a-2", profile.Username)
+
+	sameClient, err := factory.Client(context.Background(), "child-a")
+	require.NoError(t, err)
+	require.Same(t, client, sameClient)
+
+	// The parent client should not be affected by the child client
+	profile, err = parent.GetProfile(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "parent", profile.Username)
+
+	require.NoError(t, factory.Close(context.Background()))
+	require.Equal(t, []int{1, 2}, revoked())
+
+	_, err = factory.Client(context.Background(), "child-a")
+	require.ErrorContains(t, err, "closed")
+
+	// Clients handed out before the factory was closed must not create new tokens
+	_, err = client.GetProfile(context.Background())
+	require.ErrorIs(t, err, errChildAccountFactoryClosed)
+	require.Equal(t, []int{1, 2}, revoked())
+}
+
+func TestChildAccountClientFactory_ConcurrentClients(t *testing.T) {
+	server, _ := newTestChildAccountServer(t)
+
+	blocked := make(chan struct{})
+	release := make(chan struct{})
+
+	// Block token creation for child-a to check that child-b isn't serialized behind it
+	blocking := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v4/account/child-accounts/child-a/token" {
+			close(blocked)
+			<-release
+		}
+
+		server.Config.Handler.ServeHTTP(w, r)
+	})
+
+	proxy := httptest.NewServer(blocking)
+	t.Cleanup(proxy.Close)
+
+	parent := newTestClient(t, proxy.Client())
+	parent.SetBaseURL(proxy.URL)
+	parent.SetToken("parent")
+
+	factory := parent.NewChildAccountClientFactory(nil)
+
+	done := make(chan error, 1)
+
+	go func() {
+		_, err := factory.Client(context.Background(), "child-a")
+		done <- err
+	}()
+
+	<-blocked
+
+	_, err := factory.Client(context.Background(), "child-b")
+	require.NoError(t, err)
+
+	close(release)
+	require.NoError(t, <-done)
+	require.NoError(t, factory.Close(context.Background()))
+}
+
+func TestChildAccountClientFactory_ConcurrentRetrieve(t *testing.T) {
+	server, revoked := newTestChildAccountServer(t)
+
+	blocked := make(chan struct{})
+	release := make(chan struct{})
+
+	var created atomic.Int32
+
+	blocking := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/token") && created.Add(1) == 1 {
+			close(blocked)
+			<-release
+		}
+
+		server.Config.Handler.ServeHTTP(w, r)
+	})
+
+	proxy := httptest.NewServer(blocking)
+	t.Cleanup(proxy.Close)
+
+	parent := newTestClient(t, proxy.Client())
+	parent.SetBaseURL(proxy.URL)
+	parent.SetToken("parent")
+
+	factory := parent.NewChildAccountClientFactory(&ChildAccountClientFactoryOptions{RefreshWindow: time.Second})
+	provider := &childAccountCredentialProvider{factory: factory, euuid: "child-a"}
+
+	results := make(chan *Credentials, 2)
+
+	for range 2 {
+		go func() {
+			creds, err := provider.Retrieve(context.Background())
+			assert.NoError(t, err)
+			results <- creds
+		}()
+	}
+
+	<-blocked
+
+	// The provider must not be locked while a token is created
+	provider.Invalidate()
+
+	close(release)
+
+	// Concurrent callers share a single token
+	require.Equal(t, "child-a-1", (<-results).Token)
+	require.Equal(t, "child-a-1", (<-results).Token)
+	require.EqualValues(t, 1, created.Load())
+
+	require.NoError(t, provider.revoke(context.Background()))
+	require.Equal(t, []int{1}, revoked())
+}
+
+func TestChildAccountClientFactory_ForEach(t *testing.T) {
+	server, revoked := newTestChildAccountServer(t)
+
+	parent := newTestClient(t, server.Client())
+	parent.SetBaseURL(server.URL)
+	parent.SetToken("parent")
+
+	factory := parent.NewChildAccountClientFactory(&ChildAccountClientFactoryOptions{RefreshWindow: time.Second})
+
+	var visited []string
+
+	err := factory.ForEach(context.Background(), nil, func(ctx context.Context, child ChildAccount, client *Client) error {
+		profile, err := client.GetProfile(ctx)
+		if err != nil {
+			return err
+		}
+
+		visited = append(visited, child.EUUID+"="+profile.Username)
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"child-a=child-a-1", "child-b=child-b-2"}, visited)
+
+	mc, err := factory.MultiClient(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, mc.Targets, 2)
+	require.Equal(t, "child-b", mc.Targets[1].Name)
+
+	require.NoError(t, factory.Revoke(context.Background(), "child-b"))
+	require.Equal(t, []int{2}, revoked())
+
+	require.NoError(t, factory.Close(context.Background()))
+	require.Equal(t, []int{2, 1}, revoked())
+}
@@ -155,6 +155,7 @@ const (
 	ActionLinodeConfigUpdate                      EventAction = "linode_config_update"
 	ActionLishBoot                                EventAction = "lish_boot"
 	ActionLKENodeCreate                           EventAction = "lke_node_create"
+	ActionLKEPoolRecycle                          EventAction = "lke_pool_recycle"
 	ActionLKEControlPlaneACLCreate                EventAction = "lke_control_plane_acl_create"
 	ActionLKEControlPlaneACLUpdate                EventAction = "lke_control_plane_acl_update"
 	ActionLKEControlPlaneACLDelete                EventAction = "lke_control_plane_acl_delete"
@@ -238,34 +239,36 @@ type EntityType string
 
 // EntityType constants are the entities an Event can be related to.
 const (
-	EntityAccount        EntityType = "account"
-	EntityBackups        EntityType = "backups"
-	EntityCommunity      EntityType = "community"
-	EntityDatabase       EntityType = "database"
-	EntityDisk           EntityType = "disk"
-	EntityDomain         EntityType = "domain"
-	EntityTransfer       EntityType = "entity_transfer"
-	EntityFirewall       EntityType = "firewall"
-	EntityImage          EntityType = "image"
-	EntityIPAddress      EntityType = "ipaddress"
-	EntityLinode         EntityType = "linode"
-	EntityLKECluster     EntityType = "lkecluster"
-	EntityLKENodePool    EntityType = "lkenodepool"
-	EntityLongview       EntityType = "longview"
-	EntityManagedService EntityType = "managed_service"
-	EntityNodebalancer   EntityType = "nodebalancer"
-	EntityOAuthClient    EntityType = "oauth_client"
-	EntityPlacementGroup EntityType = "placement_group"
-	EntityProfile        EntityType = "profile"
-	EntityStackscript    EntityType = "stackscript"
-	EntityTag            EntityType = "tag"
-	EntityTicket         EntityType = "ticket"
-	EntityToken          EntityType = "token"
-	EntityUser           EntityType = "user"
-	EntityUserSSHKey     EntityType = "user_ssh_key"
-	EntityVolume         EntityType = "volume"
-	EntityVPC            EntityType = "vpc"
-	EntityVPCSubnet      EntityType = "subnet"
+	EntityAccount            EntityType = "account"
+	EntityBackups            EntityType = "backups"
+	EntityCommunity          EntityType = "community"
+	EntityDatabase           EntityType = "database"
+	EntityDisk               EntityType = "disk"
+	EntityDomain             EntityType = "domain"
+	EntityTransfer           EntityType = "entity_transfer"
+	EntityFirewall           EntityType = "firewall"
+	EntityImage              EntityType = "image"
+	EntityIPAddress          EntityType = "ipaddress"
+	EntityLinode             EntityType = "linode"
+	EntityLKECluster         EntityType = "lkecluster"
+	EntityLKENodePool        EntityType = "lkenodepool"
+	EntityLongview           EntityType = "longview"
+	EntityManagedService     EntityType = "managed_service"
+	EntityNodebalancer       EntityType = "nodebalancer"
+	EntityNodebalancerConfig EntityType = "nodebalancer_config"
+	EntityObjectStorageKey   EntityType = "object_storage_key"
+	EntityOAuthClient        EntityType = "oauth_client"
+	EntityPlacementGroup     EntityType = "placement_group"
+	EntityProfile            EntityType = "profile"
+	EntityStackscript        EntityType = "stackscript"
+	EntityTag                EntityType = "tag"
+	EntityTicket             EntityType = "ticket"
+	EntityToken              EntityType = "token"
+	EntityUser               EntityType = "user"
+	EntityUserSSHKey         EntityType = "user_ssh_key"
+	EntityVolume             EntityType = "volume"
+	EntityVPC                EntityType = "vpc"
+	EntityVPCSubnet          EntityType = "subnet"
 )
 
 // EventStatus constants start with Event and include Linode API Event Status values
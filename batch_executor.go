@@ -0,0 +1,435 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// DefaultBatchConcurrency is the default maximum number of operations run concurrently by RunBatch(...).
+const DefaultBatchConcurrency = 8
+
+// BatchErrorPolicy determines how a batch proceeds after an operation fails.
+type BatchErrorPolicy int
+
+// BatchErrorPolicy enums determine how a batch proceeds after an operation fails.
+const (
+	// BatchContinueOnError runs all remaining operations which don't depend on the failed operation.
+	BatchContinueOnError BatchErrorPolicy = iota
+
+	// BatchStopOnError skips all operations which have not yet started.
+	BatchStopOnError
+)
+
+// BatchResultStatus is the outcome of an operation in a batch.
+type BatchResultStatus string
+
+// BatchResultStatus enums represent the outcomes of an operation in a batch.
+const (
+	BatchSucceeded BatchResultStatus = "succeeded"
+	BatchFailed    BatchResultStatus = "failed"
+	BatchSkipped   BatchResultStatus = "skipped"
+)
+
+// BatchOperation is an operation run by RunBatch(...).
+type BatchOperation struct {
+	// Key identifies the operation in the batch's report and in the DependsOn field
+	// of other operations. Defaults to the operation's index in the batch.
+	Key string
+
+	// DependsOn lists the keys of the operations which must succeed before this operation is run.
+	// The operation is skipped if any of them fail or are skipped.
+	DependsOn []string
+
+	// Run performs the operation using the given client.
+	Run func(ctx context.Context, client *Client) error
+}
+
+// BatchOptions configures RunBatch(...).
+type BatchOptions struct {
+	// Concurrency is the maximum number of operations run concurrently.
+	// Defaults to DefaultBatchConcurrency.
+	Concurrency int
+
+	// Policy determines how the batch proceeds after an operation fails.
+	// Defaults to BatchContinueOnError.
+	Policy BatchErrorPolicy
+
+	// OnResult is called with the result of each operation as it settles.
+	OnResult func(result BatchResult)
+}
+
+// BatchResult is the outcome of an operation in a batch.
+type BatchResult struct {
+	Key    string
+	Status BatchResultStatus
+
+	// Err is the error returned by a failed operation, or the reason a skipped operation was skipped.
+	Err error
+
+	// APIError is the error returned by the Linode API for a failed operation, if any.
+	APIError *Error
+
+	// Duration is the time taken to run the operation.
+	Duration time.Duration
+}
+
+// BatchReport summarizes the outcome of a batch.
+type BatchReport struct {
+	// Results contains the result of each operation in the order the operations were given.
+	Results []BatchResult
+}
+
+// Succeeded returns the results of the operations which succeeded.
+func (r *BatchReport) Succeeded() []BatchResult {
+	return r.withStatus(BatchSucceeded)
+}
+
+// Failed returns the results of the operations which failed.
+func (r *BatchReport) Failed() []BatchResult {
+	return r.withStatus(BatchFailed)
+}
+
+// Skipped returns the results of the operations which were skipped.
+func (r *BatchReport) Skipped() []BatchResult {
+	return r.withStatus(BatchSkipped)
+}
+
+// Err returns an error joining the errors of all failed operations, or nil if none failed.
+func (r *BatchReport) Err() error {
+	errs := make([]error, 0)
+
+	for _, result := range r.Failed() {
+		errs = append(errs, fmt.Errorf("operation %s failed: %w", result.Key, result.Err))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *BatchReport) withStatus(status BatchResultStatus) []BatchResult {
+	result := make([]BatchResult, 0)
+
+	for _, item := range r.Results {
+		if item.Status == status {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+// BatchOperations creates a batch operation for each of the given items,
+// e.g. to tag or delete a list of instances.
+func BatchOperations[T any](
+	items []T,
+	key func(item T) string,
+	run func(ctx context.Context, client *Client, item T) error,
+) []BatchOperation {
+	result := make([]BatchOperation, len(items))
+
+	for i, item := range items {
+		result[i] = BatchOperation{
+			Key: key(item),
+			Run: func(ctx context.Context, client *Client) error {
+				return run(ctx, client, item)
+			},
+		}
+	}
+
+	return result
+}
+
+// RunBatch runs the given operations with bounded concurrency, respecting their dependencies,
+// and returns a report of their outcomes. Failed operations are not returned as an error;
+// use the report's Err() method to check for failures.
+//
+// Operations are not retried by the batch, as the client already retries requests according
+// to its retry configuration. If an operation is rate limited regardless, no further operations
+// are started until the duration given by the API's Retry-After header has passed.
+//
+// An error is returned if the operations are invalid, e.g. have duplicate keys or cyclic dependencies.
+func (c *Client) RunBatch(ctx context.Context, operations []BatchOperation, opts *BatchOptions) (*BatchReport, error) {
+	batch, err := newBatchRun(c, operations, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	batch.run(ctx)
+
+	return &BatchReport{Results: batch.results}, nil
+}
+
+type batchRun struct {
+	client     *Client
+	operations []BatchOperation
+	opts       BatchOptions
+
+	keys       map[string]int
+	remaining  []int
+	dependents [][]int
+	settled    []bool
+	results    []BatchResult
+
+	ready   []int
+	running int
+	stopped error
+}
+
+type batchCompletion struct {
+	index  int
+	result BatchResult
+}
+
+func newBatchRun(c *Client, operations []BatchOperation, opts *BatchOptions) (*batchRun, error) {
+	result := &batchRun{
+		client:     c,
+		operations: slices.Clone(operations),
+		keys:       make(map[string]int, len(operations)),
+		remaining:  make([]int, len(operations)),
+		dependents: make([][]int, len(operations)),
+		settled:    make([]bool, len(operations)),
+		results:    make([]BatchResult, len(operations)),
+	}
+
+	if opts != nil {
+		result.opts = *opts
+	}
+
+	if result.opts.Concurrency <= 0 {
+		result.opts.Concurrency = DefaultBatchConcurrency
+	}
+
+	for i := range result.operations {
+		op := &result.operations[i]
+
+		if op.Key == "" {
+			op.Key = strconv.Itoa(i)
+		}
+
+		if op.Run == nil {
+			return nil, fmt.Errorf("batch operation %s has no Run function", op.Key)
+		}
+
+		if _, ok := result.keys[op.Key]; ok {
+			return nil, fmt.Errorf("duplicate batch operation key %s", op.Key)
+		}
+
+		result.keys[op.Key] = i
+	}
+
+	for i, op := range result.operations {
+		for _, dependency := range op.DependsOn {
+			dependencyIndex, ok := result.keys[dependency]
+			if !ok {
+				return nil, fmt.Errorf("batch operation %s depends on unknown operation %s", op.Key, dependency)
+			}
+
+			result.remaining[i]++
+			result.dependents[dependencyIndex] = append(result.dependents[dependencyIndex], i)
+		}
+
+		if result.remaining[i] == 0 {
+			result.ready = append(result.ready, i)
+		}
+	}
+
+	if err := result.checkCycles(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// checkCycles returns an error if the operations' dependencies contain a cycle.
+func (b *batchRun) checkCycles() error {
+	remaining := slices.Clone(b.remaining)
+	queue := slices.Clone(b.ready)
+	visited := 0
+
+	for len(queue) > 0 {
+		index := queue[0]
+		queue = queue[1:]
+		visited++
+
+		for _, dependent := range b.dependents[index] {
+			remaining[dependent]--
+			if remaining[dependent] == 0 {
+				queue = append(queue, dependent)
+			}
+		}
+	}
+
+	if visited == len(b.operations) {
+		return nil
+	}
+
+	for i, count := range remaining {
+		if count > 0 {
+			return fmt.Errorf("batch operation %s has cyclic dependencies", b.operations[i].Key)
+		}
+	}
+
+	return nil
+}
+
+func (b *batchRun) run(ctx context.Context) {
+	completions := make(chan batchCompletion)
+
+	var pause *time.Timer
+
+	for {
+		if b.stopped == nil && ctx.Err() != nil {
+			b.stopped = ctx.Err()
+		}
+
+		if b.stopped == nil && pause == nil {
+			for len(b.ready) > 0 && b.running < b.opts.Concurrency {
+				index := b.ready[0]
+				b.ready = b.ready[1:]
+				b.running++
+
+				go func() {
+					completions <- batchCompletion{index: index, result: b.runOperation(ctx, index)}
+				}()
+			}
+		}
+
+		if b.running == 0 && (b.stopped != nil || len(b.ready) == 0) {
+			break
+		}
+
+		var pauseC <-chan time.Time
+		if pause != nil {
+			pauseC = pause.C
+		}
+
+		select {
+		case completion := <-completions:
+			b.running--
+			b.complete(completion.index, completion.result)
+
+			if wait := b.rateLimitWait(completion.result); wait > 0 && pause == nil {
+				pause = time.NewTimer(wait)
+			}
+		case <-pauseC:
+			pause = nil
+		case <-ctx.Done():
+			if b.stopped == nil {
+				b.stopped = ctx.Err()
+			}
+
+			// Wait for the running operations to return
+			if b.running > 0 {
+				completion := <-completions
+				b.running--
+				b.complete(completion.index, completion.result)
+			}
+		}
+	}
+
+	if pause != nil {
+		pause.Stop()
+	}
+
+	for i := range b.operations {
+		if !b.settled[i] {
+			b.settle(i, BatchResult{Status: BatchSkipped, Err: b.stopped})
+		}
+	}
+}
+
+// runOperation runs the operation at the given index, returning its result.
+func (b *batchRun) runOperation(ctx context.Context, index int) (result BatchResult) {
+	start := time.Now()
+
+	defer func() {
+		// Isolate panicking operations from the batch
+		if r := recover(); r != nil {
+			result = BatchResult{Status: BatchFailed, Err: fmt.Errorf("batch operation panicked: %v", r)}
+		}
+
+		result.Duration = time.Since(start)
+	}()
+
+	if err := b.operations[index].Run(ctx, b.client); err != nil {
+		result = BatchResult{Status: BatchFailed, Err: err}
+
+		var apiErr *Error
+		if errors.As(err, &apiErr) {
+			result.APIError = apiErr
+		}
+
+		return result
+	}
+
+	return BatchResult{Status: BatchSucceeded}
+}
+
+// complete records the result of the operation at the given index and
+// updates the state of the operations depending on it.
+func (b *batchRun) complete(index int, result BatchResult) {
+	b.settle(index, result)
+
+	if result.Status == BatchFailed && b.opts.Policy == BatchStopOnError && b.stopped == nil {
+		b.stopped = fmt.Errorf("batch stopped after operation %s failed", b.operations[index].Key)
+	}
+
+	for _, dependent := range b.dependents[index] {
+		if result.Status != BatchSucceeded {
+			b.skipDependent(dependent, index)
+			continue
+		}
+
+		b.remaining[dependent]--
+		if b.remaining[dependent] == 0 && !b.settled[dependent] {
+			b.ready = append(b.ready, dependent)
+			slices.Sort(b.ready)
+		}
+	}
+}
+
+// skipDependent skips the operation at the given index and all operations
+// depending on it because the given dependency did not succeed.
+func (b *batchRun) skipDependent(index, dependency int) {
+	if b.settled[index] {
+		return
+	}
+
+	b.settle(index, BatchResult{
+		Status: BatchSkipped,
+		Err:    fmt.Errorf("dependency %s did not succeed", b.operations[dependency].Key),
+	})
+
+	for _, dependent := range b.dependents[index] {
+		b.skipDependent(dependent, index)
+	}
+}
+
+func (b *batchRun) settle(index int, result BatchResult) {
+	result.Key = b.operations[index].Key
+
+	b.settled[index] = true
+	b.results[index] = result
+
+	if b.opts.OnResult != nil {
+		b.opts.OnResult(result)
+	}
+}
+
+// rateLimitWait returns the duration to wait before starting further operations
+// if the given result failed due to rate limiting.
+func (b *batchRun) rateLimitWait(result BatchResult) time.Duration {
+	if result.APIError == nil || result.APIError.StatusCode() != http.StatusTooManyRequests {
+		return 0
+	}
+
+	if wait, err := RespectRetryAfter(result.APIError.Response); err == nil && wait > 0 {
+		return wait
+	}
+
+	return b.client.GetPollDelay()
+}
@@ -0,0 +1,252 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBatchLoaderWindow is the default duration a BatchLoader waits to
+	// collect IDs before resolving them with a single list request.
+	DefaultBatchLoaderWindow = 10 * time.Millisecond
+
+	// DefaultBatchLoaderMaxBatchSize is the default maximum number of IDs
+	// resolved by a single list request.
+	DefaultBatchLoaderMaxBatchSize = 100
+
+	// batchLoaderMinPageSize is the minimum page size accepted by the API.
+	batchLoaderMinPageSize = 25
+
+	// batchLoaderMaxPageSize is the maximum page size accepted by the API.
+	batchLoaderMaxPageSize = 500
+)
+
+// BatchLoaderOptions configures a BatchLoader.
+type BatchLoaderOptions struct {
+	// Window is the duration to collect get-by-ID calls before they are resolved.
+	// Defaults to DefaultBatchLoaderWindow.
+	Window time.Duration
+
+	// MaxBatchSize is the maximum number of IDs resolved by a single list request.
+	// A batch is flushed immediately once it reaches this size.
+	// Defaults to DefaultBatchLoaderMaxBatchSize.
+	MaxBatchSize int
+}
+
+// BatchLoader coalesces concurrent get-by-ID calls into filtered list requests.
+// Calls made within the configured window are resolved using a single list request
+// filtered using an "+or" over the requested IDs.
+// IDs missing from the list response are resolved to a 404 Not Found Error.
+type BatchLoader[T any] struct {
+	list   func(ctx context.Context, opts *ListOptions) ([]T, error)
+	idFunc func(T) int
+
+	window       time.Duration
+	maxBatchSize int
+
+	lock    sync.Mutex
+	pending *batchLoaderBatch[T]
+}
+
+type batchLoaderResult[T any] struct {
+	value *T
+	err   error
+}
+
+type batchLoaderBatch[T any] struct {
+	ctx     context.Context
+	waiters map[int][]chan batchLoaderResult[T]
+	timer   *time.Timer
+}
+
+// NewInstanceBatchLoader creates a new BatchLoader that resolves Instances by ID.
+func (c *Client) NewInstanceBatchLoader(opts *BatchLoaderOptions) *BatchLoader[Instance] {
+	return newBatchLoader(c.ListInstances, func(i Instance) int { return i.ID }, opts)
+}
+
+// NewVolumeBatchLoader creates a new BatchLoader that resolves Volumes by ID.
+func (c *Client) NewVolumeBatchLoader(opts *BatchLoaderOptions) *BatchLoader[Volume] {
+	return newBatchLoader(c.ListVolumes, func(v Volume) int { return v.ID }, opts)
+}
+
+// NewNodeBalancerBatchLoader creates a new BatchLoader that resolves NodeBalancers by ID.
+func (c *Client) NewNodeBalancerBatchLoader(opts *BatchLoaderOptions) *BatchLoader[NodeBalancer] {
+	return newBatchLoader(c.ListNodeBalancers, func(n NodeBalancer) int { return n.ID }, opts)
+}
+
+func newBatchLoader[T any](
+	list func(ctx context.Context, opts *ListOptions) ([]T, error),
+	idFunc func(T) int,
+	opts *BatchLoaderOptions,
+) *BatchLoader[T] {
+	result := &BatchLoader[T]{
+		list:         list,
+		idFunc:       idFunc,
+		window:       DefaultBatchLoaderWindow,
+		maxBatchSize: DefaultBatchLoaderMaxBatchSize,
+	}
+
+	if opts != nil {
+		if opts.Window > 0 {
+			result.window = opts.Window
+		}
+
+		if opts.MaxBatchSize > 0 {
+			result.maxBatchSize = opts.MaxBatchSize
+		}
+	}
+
+	return result
+}
+
+// Get gets the object with the given ID, batching the request
+// with any other Get calls made within the loader's window.
+func (l *BatchLoader[T]) Get(ctx context.Context, id int) (*T, error) {
+	resultChan := make(chan batchLoaderResult[T], 1)
+
+	l.enqueue(ctx, id, resultChan)
+
+	select {
+	case result := <-resultChan:
+		return result.value, result.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("failed to wait for batched get of ID %d: %w", id, ctx.Err())
+	}
+}
+
+func (l *BatchLoader[T]) enqueue(ctx context.Context, id int, resultChan chan batchLoaderResult[T]) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.pending == nil {
+		batch := &batchLoaderBatch[T]{
+			// The batch should not be canceled if the first caller gives up
+			ctx:     context.WithoutCancel(ctx),
+			waiters: make(map[int][]chan batchLoaderResult[T]),
+		}
+
+		batch.timer = time.AfterFunc(l.window, func() {
+			l.flush(batch)
+		})
+
+		l.pending = batch
+	}
+
+	l.pending.waiters[id] = append(l.pending.waiters[id], resultChan)
+
+	if len(l.pending.waiters) >= l.maxBatchSize {
+		batch := l.pending
+		l.pending = nil
+
+		if batch.timer.Stop() {
+			go l.resolve(batch)
+		}
+	}
+}
+
+// flush resolves the given batch if it is still pending.
+func (l *BatchLoader[T]) flush(batch *batchLoaderBatch[T]) {
+	l.lock.Lock()
+
+	if l.pending == batch {
+		l.pending = nil
+	}
+
+	l.lock.Unlock()
+
+	l.resolve(batch)
+}
+
+func (l *BatchLoader[T]) resolve(batch *batchLoaderBatch[T]) {
+	ids := make([]int, 0, len(batch.waiters))
+	for id := range batch.waiters {
+		ids = append(ids, id)
+	}
+
+	results, err := listByIDs(batch.ctx, l.list, l.idFunc, ids)
+
+	for id, waiters := range batch.waiters {
+		value, found := results[id]
+
+		for _, waiter := range waiters {
+			switch {
+			case err != nil:
+				waiter <- batchLoaderResult[T]{err: err}
+			case !found:
+				waiter <- batchLoaderResult[T]{err: newIDNotFoundError(id)}
+			default:
+				// Each caller receives its own copy of the result
+				valueCopy := value
+				waiter <- batchLoaderResult[T]{value: &valueCopy}
+			}
+		}
+	}
+}
+
+// listByIDs lists the entries with the given IDs using a single list request
+// filtered using an "+or" over the IDs, returning the found entries by ID.
+func listByIDs[T any](
+	ctx context.Context,
+	list func(ctx context.Context, opts *ListOptions) ([]T, error),
+	idFunc func(T) int,
+	ids []int,
+) (map[int]T, error) {
+	nodes := make([]FilterNode, len(ids))
+	for i, id := range ids {
+		nodes[i] = &Comp{Column: "id", Operator: Eq, Value: id}
+	}
+
+	filter, err := Or("", "", nodes...).MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create batch filter: %w", err)
+	}
+
+	listOpts := ListOptions{
+		Filter:   string(filter),
+		PageSize: min(max(len(ids), batchLoaderMinPageSize), batchLoaderMaxPageSize),
+	}
+
+	entries, err := list(ctx, &listOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int]T, len(entries))
+	for _, entry := range entries {
+		result[idFunc(entry)] = entry
+	}
+
+	return result, nil
+}
+
+// listByIDsChunked lists the entries with the given IDs using one list request per
+// DefaultBatchLoaderMaxBatchSize IDs, returning the found entries by ID.
+func listByIDsChunked[T any](
+	ctx context.Context,
+	list func(ctx context.Context, opts *ListOptions) ([]T, error),
+	idFunc func(T) int,
+	ids []int,
+) (map[int]T, error) {
+	result := make(map[int]T, len(ids))
+
+	for chunk := range slices.Chunk(ids, DefaultBatchLoaderMaxBatchSize) {
+		entries, err := listByIDs(ctx, list, idFunc, chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		maps.Copy(result, entries)
+	}
+
+	return result, nil
+}
+
+// newIDNotFoundError creates a 404 Not Found Error for an ID missing from a list response.
+func newIDNotFoundError(id int) *Error {
+	return &Error{Code: http.StatusNotFound, Message: fmt.Sprintf("ID %d not found", id)}
+}
@@ -0,0 +1,572 @@
+package linodego
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BillingGrouping is a dimension which billing exports are summarized by.
+type BillingGrouping string
+
+// BillingGrouping constants are the dimensions billing exports are summarized by.
+const (
+	BillingGroupByTag    BillingGrouping = "tag"
+	BillingGroupByRegion BillingGrouping = "region"
+	BillingGroupByType   BillingGrouping = "type"
+)
+
+// BillingExportOptions configures ExportBilling(...).
+type BillingExportOptions struct {
+	// From and To restrict the export to invoices dated within [From, To).
+	// A zero value leaves that end of the range unbounded.
+	From time.Time
+	To   time.Time
+
+	// Snapshots are earlier inventory snapshots used to attribute invoice items to
+	// resources which have since been deleted. More recent snapshots take precedence,
+	// and the account's current resources take precedence over all snapshots.
+	Snapshots []*InventorySnapshot
+}
+
+// BillingLineItem is an invoice item joined to the resource it bills for.
+type BillingLineItem struct {
+	InvoiceID   int        `json:"invoice_id"`
+	InvoiceDate *time.Time `json:"invoice_date,omitzero"`
+
+	Label  string     `json:"label"`
+	Type   string     `json:"type"`
+	Region string     `json:"region,omitzero"`
+	From   *time.Time `json:"from,omitzero"`
+	To     *time.Time `json:"to,omitzero"`
+	Amount float64    `json:"amount"`
+	Tax    float64    `json:"tax"`
+	Total  float64    `json:"total"`
+
+	// EntityType, EntityID, EntityLabel and Tags describe the resource the item bills for,
+	// and are only set for attributed items.
+	EntityType  EntityType `json:"entity_type,omitzero"`
+	EntityID    int        `json:"entity_id,omitzero"`
+	EntityLabel string     `json:"entity_label,omitzero"`
+	Tags        []string   `json:"tags,omitzero"`
+
+	// Reason explains why an unattributed item couldn't be joined to a resource.
+	Reason string `json:"reason,omitzero"`
+}
+
+// BillingSummary is the sum of the amounts of a group of billing line items.
+type BillingSummary struct {
+	Amount float64 `json:"amount"`
+	Tax    float64 `json:"tax"`
+	Total  float64 `json:"total"`
+}
+
+func (s BillingSummary) add(item BillingLineItem) BillingSummary {
+	return BillingSummary{
+		Amount: roundCents(s.Amount + item.Amount),
+		Tax:    roundCents(s.Tax + item.Tax),
+		Total:  roundCents(s.Total + item.Total),
+	}
+}
+
+// BillingExport is the invoice items within a date range broken down by resource.
+type BillingExport struct {
+	From time.Time `json:"from,omitzero"`
+	To   time.Time `json:"to,omitzero"`
+
+	// Items are the invoice items which could be attributed to a resource.
+	Items []BillingLineItem `json:"items"`
+
+	// Unattributed are the invoice items which couldn't be attributed to a resource,
+	// e.g. network transfer overages, Object Storage and items for resources which were
+	// deleted before any of the given snapshots were taken.
+	Unattributed []BillingLineItem `json:"unattributed"`
+
+	// Total sums all items, while ByTag, ByRegion and ByType only summarize attributed items.
+	// Items with several tags are counted under each of them, and untagged items are grouped
+	// under the empty string.
+	Total    BillingSummary            `json:"total"`
+	ByTag    map[string]BillingSummary `json:"by_tag"`
+	ByRegion map[string]BillingSummary `json:"by_region"`
+	ByType   map[string]BillingSummary `json:"by_type"`
+}
+
+// billingResourceTypes maps the inventory resource types which appear on invoices to their entity types.
+var billingResourceTypes = map[string]EntityType{
+	"instances":          EntityLinode,
+	"lke_clusters":       EntityLKECluster,
+	"mysql_databases":    EntityDatabase,
+	"nodebalancers":      EntityNodebalancer,
+	"postgres_databases": EntityDatabase,
+	"volumes":            EntityVolume,
+}
+
+// billingLabelTypes maps keywords found in the descriptions of invoice items to the type of
+// resource billed, in the order they are matched, e.g. backups are billed per instance.
+var billingLabelTypes = []struct {
+	keyword    string
+	entityType EntityType
+}{
+	{"backup", EntityLinode},
+	{"nodebalancer", EntityNodebalancer},
+	{"volume", EntityVolume},
+	{"block storage", EntityVolume},
+	{"kubernetes", EntityLKECluster},
+	{"lke", EntityLKECluster},
+	{"database", EntityDatabase},
+	{"linode", EntityLinode},
+	{"nanode", EntityLinode},
+	{"dedicated", EntityLinode},
+	{"premium", EntityLinode},
+	{"high memory", EntityLinode},
+	{"gpu", EntityLinode},
+}
+
+// billingLabelID matches the parenthesized resource ID in an invoice item label,
+// e.g. "Linode 4GB - web-1 (12345)".
+var billingLabelID = regexp.MustCompile(`\((\d+)\)`)
+
+type billingEntityKey struct {
+	entityType EntityType
+	id         int
+}
+
+// billingEntityLabel identifies resources by type and label, for invoice items whose labels
+// don't include the resource's ID, e.g. "Linode 2GB - web-1".
+type billingEntityLabel struct {
+	entityType EntityType
+	label      string
+}
+
+type billingEntity struct {
+	Label  *string  `json:"label"`
+	Region string   `json:"region"`
+	Tags   []string `json:"tags"`
+}
+
+// ExportBilling lists the invoices within a date range and joins their items to the
+// resources they bill for, summarizing the items by tag, region and resource type.
+//
+// Invoice items don't reference resources directly, so items are attributed by the
+// resource type and ID in their labels, or by the resource's label for items without an ID,
+// e.g. "Linode 2GB - web-1", if it is unique. Items are joined to the account's current
+// resources, falling back to the given snapshots for resources which have since
+// been deleted. Resource types which can't be listed, e.g. due to missing permissions,
+// are skipped, leaving their items unattributed.
+func (c *Client) ExportBilling(ctx context.Context, opts *BillingExportOptions) (*BillingExport, error) {
+	if opts == nil {
+		opts = &BillingExportOptions{}
+	}
+
+	current, err := c.TakeInventorySnapshot(ctx, &InventorySnapshotOptions{
+		ResourceTypes: slices.Sorted(maps.Keys(billingResourceTypes)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entities, err := indexBillingEntities(append(slices.Clone(opts.Snapshots), current))
+	if err != nil {
+		return nil, err
+	}
+
+	labels := indexBillingLabels(entities)
+
+	listOpts, err := billingInvoiceListOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	invoices, err := c.ListInvoices(ctx, listOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invoices: %w", err)
+	}
+
+	export := &BillingExport{
+		From:         opts.From,
+		To:           opts.To,
+		Items:        make([]BillingLineItem, 0),
+		Unattributed: make([]BillingLineItem, 0),
+		ByTag:        make(map[string]BillingSummary),
+		ByRegion:     make(map[string]BillingSummary),
+		ByType:       make(map[string]BillingSummary),
+	}
+
+	for _, invoice := range invoices {
+		if invoice.Date == nil ||
+			(!opts.From.IsZero() && invoice.Date.Before(opts.From)) ||
+			(!opts.To.IsZero() && !invoice.Date.Before(opts.To)) {
+			continue
+		}
+
+		items, err := c.ListInvoiceItems(ctx, invoice.ID, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list items of invoice %d: %w", invoice.ID, err)
+		}
+
+		for _, item := range items {
+			export.add(attributeBillingItem(invoice, item, entities, labels))
+		}
+	}
+
+	return export, nil
+}
+
+// billingInvoiceListOptions returns the options to list the invoices dated within the export's range.
+func billingInvoiceListOptions(opts *BillingExportOptions) (*ListOptions, error) {
+	listOpts := &ListOptions{}
+	filter := Filter{Operator: "+and"}
+
+	if !opts.From.IsZero() {
+		filter.AddField(Gte, "date", opts.From.UTC().Format("2006-01-02T15:04:05"))
+	}
+
+	if !opts.To.IsZero() {
+		filter.AddField(Lt, "date", opts.To.UTC().Format("2006-01-02T15:04:05"))
+	}
+
+	if len(filter.Children) == 0 {
+		return listOpts, nil
+	}
+
+	filterStr, err := filter.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal invoice filter: %w", err)
+	}
+
+	listOpts.Filter = string(filterStr)
+
+	return listOpts, nil
+}
+
+// indexBillingEntities indexes the billable resources in the given snapshots, with resources
+// in more recent snapshots replacing those in older ones.
+func indexBillingEntities(snapshots []*InventorySnapshot) (map[billingEntityKey]billingEntity, error) {
+	snapshots = slices.Clone(snapshots)
+	slices.SortStableFunc(snapshots, func(a, b *InventorySnapshot) int {
+		return a.TakenAt.Compare(b.TakenAt)
+	})
+
+	result := make(map[billingEntityKey]billingEntity)
+
+	for _, snapshot := range snapshots {
+		for resourceType, entityType := range billingResourceTypes {
+			for id, data := range snapshot.Resources[resourceType] {
+				entityID, err := strconv.Atoi(id)
+				if err != nil {
+					continue
+				}
+
+				var entity billingEntity
+				if err := json.Unmarshal(data, &entity); err != nil {
+					return nil, fmt.Errorf("failed to parse %s %s: %w", resourceType, id, err)
+				}
+
+				result[billingEntityKey{entityType, entityID}] = entity
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// indexBillingLabels indexes the IDs of the given resources by their type and label.
+func indexBillingLabels(entities map[billingEntityKey]billingEntity) map[billingEntityLabel][]int {
+	result := make(map[billingEntityLabel][]int)
+
+	for key, entity := range entities {
+		if entity.Label == nil || *entity.Label == "" {
+			continue
+		}
+
+		labelKey := billingEntityLabel{key.entityType, *entity.Label}
+		result[labelKey] = append(result[labelKey], key.id)
+	}
+
+	return result
+}
+
+func attributeBillingItem(
+	invoice Invoice,
+	item InvoiceItem,
+	entities map[billingEntityKey]billingEntity,
+	labels map[billingEntityLabel][]int,
+) BillingLineItem {
+	result := BillingLineItem{
+		InvoiceID:   invoice.ID,
+		InvoiceDate: invoice.Date,
+		Label:       item.Label,
+		Type:        item.Type,
+		From:        item.From,
+		To:          item.To,
+		Amount:      roundCents(float64(item.Amount)),
+		Tax:         roundCents(float64(item.Tax)),
+		Total:       roundCents(float64(item.Total)),
+	}
+
+	if item.Region != nil {
+		result.Region = *item.Region
+	}
+
+	var candidates []EntityType
+
+	// Only match the description before the resource's label, e.g. "Linode 4GB" for LKE nodes
+	description, _, _ := strings.Cut(strings.ToLower(item.Label), " - ")
+
+	for _, labelType := range billingLabelTypes {
+		if strings.Contains(description, labelType.keyword) {
+			candidates = []EntityType{labelType.entityType}
+			break
+		}
+	}
+
+	matches := billingLabelID.FindAllStringSubmatch(item.Label, -1)
+	if len(matches) == 0 {
+		key, reason := findBillingEntityByLabel(item.Label, candidates, labels)
+		if reason != "" {
+			result.Reason = reason
+			return result
+		}
+
+		return attributeBillingEntity(result, key, entities[key])
+	}
+
+	id, err := strconv.Atoi(matches[len(matches)-1][1])
+	if err != nil {
+		result.Reason = "label has no resource ID"
+		return result
+	}
+
+	if candidates == nil {
+		// Fall back to any resource with the ID if the label doesn't name a resource type
+		for entityType := range maps.Values(billingResourceTypes) {
+			if _, ok := entities[billingEntityKey{entityType, id}]; ok && !slices.Contains(candidates, entityType) {
+				candidates = append(candidates, entityType)
+			}
+		}
+
+		if len(candidates) > 1 {
+			result.Reason = fmt.Sprintf("resource %d is ambiguous", id)
+			return result
+		}
+	}
+
+	for _, entityType := range candidates {
+		key := billingEntityKey{entityType, id}
+
+		if entity, ok := entities[key]; ok {
+			return attributeBillingEntity(result, key, entity)
+		}
+	}
+
+	result.Reason = fmt.Sprintf("resource %d not found", id)
+
+	return result
+}
+
+// findBillingEntityByLabel finds the resource named at the end of the given invoice item label,
+// e.g. "web-1" in "Linode 2GB - web-1", among the resources of the given types, or of any type
+// if entityTypes is empty. The reason the resource couldn't be found is returned otherwise.
+func findBillingEntityByLabel(
+	label string,
+	entityTypes []EntityType,
+	labels map[billingEntityLabel][]int,
+) (billingEntityKey, string) {
+	index := strings.LastIndex(label, " - ")
+	if index < 0 {
+		return billingEntityKey{}, "label has no resource ID"
+	}
+
+	name := strings.TrimSpace(label[index+len(" - "):])
+
+	if len(entityTypes) == 0 {
+		entityTypes = slices.Compact(slices.Sorted(maps.Values(billingResourceTypes)))
+	}
+
+	var found []billingEntityKey
+
+	for _, entityType := range entityTypes {
+		for _, id := range labels[billingEntityLabel{entityType, name}] {
+			found = append(found, billingEntityKey{entityType, id})
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return billingEntityKey{}, fmt.Sprintf("resource %s not found", name)
+	case 1:
+		return found[0], ""
+	default:
+		return billingEntityKey{}, fmt.Sprintf("resource %s is ambiguous", name)
+	}
+}
+
+// attributeBillingEntity joins the given line item to the given resource.
+func attributeBillingEntity(item BillingLineItem, key billingEntityKey, entity billingEntity) BillingLineItem {
+	item.EntityType = key.entityType
+	item.EntityID = key.id
+	item.Tags = entity.Tags
+
+	if entity.Label != nil {
+		item.EntityLabel = *entity.Label
+	}
+
+	if item.Region == "" {
+		item.Region = entity.Region
+	}
+
+	return item
+}
+
+func (e *BillingExport) add(item BillingLineItem) {
+	e.Total = e.Total.add(item)
+
+	if item.EntityType == "" {
+		e.Unattributed = append(e.Unattributed, item)
+		return
+	}
+
+	e.Items = append(e.Items, item)
+	e.ByRegion[item.Region] = e.ByRegion[item.Region].add(item)
+	e.ByType[string(item.EntityType)] = e.ByType[string(item.EntityType)].add(item)
+
+	if len(item.Tags) == 0 {
+		e.ByTag[""] = e.ByTag[""].add(item)
+	}
+
+	for _, tag := range item.Tags {
+		e.ByTag[tag] = e.ByTag[tag].add(item)
+	}
+}
+
+// Summary returns the export's summaries grouped by the given dimension.
+func (e *BillingExport) Summary(grouping BillingGrouping) (map[string]BillingSummary, error) {
+	switch grouping {
+	case BillingGroupByTag:
+		return e.ByTag, nil
+	case BillingGroupByRegion:
+		return e.ByRegion, nil
+	case BillingGroupByType:
+		return e.ByType, nil
+	default:
+		return nil, fmt.Errorf("unsupported billing grouping %s", grouping)
+	}
+}
+
+// WriteJSON writes the export as indented JSON to the given writer.
+func (e *BillingExport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(e); err != nil {
+		return fmt.Errorf("failed to encode billing export: %w", err)
+	}
+
+	return nil
+}
+
+// WriteSummaryCSV writes the export's summaries grouped by the given dimension as CSV
+// to the given writer, sorted by group. Unattributed items are summarized on a final
+// "unattributed" row.
+func (e *BillingExport) WriteSummaryCSV(w io.Writer, grouping BillingGrouping) error {
+	summaries, err := e.Summary(grouping)
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+
+	rows := [][]string{{string(grouping), "amount", "tax", "total"}}
+
+	for _, group := range slices.Sorted(maps.Keys(summaries)) {
+		rows = append(rows, billingSummaryRow(group, summaries[group]))
+	}
+
+	if len(e.Unattributed) > 0 {
+		var unattributed BillingSummary
+		for _, item := range e.Unattributed {
+			unattributed = unattributed.add(item)
+		}
+
+		rows = append(rows, billingSummaryRow("unattributed", unattributed))
+	}
+
+	if err := writer.WriteAll(rows); err != nil {
+		return fmt.Errorf("failed to write billing summary: %w", err)
+	}
+
+	return nil
+}
+
+// WriteItemsCSV writes every item in the export as CSV to the given writer,
+// with attributed items first. Tags are separated by semicolons.
+func (e *BillingExport) WriteItemsCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	rows := [][]string{{
+		"invoice_id", "invoice_date", "label", "type", "region", "from", "to", "amount", "tax", "total",
+		"entity_type", "entity_id", "entity_label", "tags", "reason",
+	}}
+
+	for _, item := range slices.Concat(e.Items, e.Unattributed) {
+		entityID := ""
+		if item.EntityID != 0 {
+			entityID = strconv.Itoa(item.EntityID)
+		}
+
+		rows = append(rows, []string{
+			strconv.Itoa(item.InvoiceID),
+			formatBillingTime(item.InvoiceDate),
+			item.Label,
+			item.Type,
+			item.Region,
+			formatBillingTime(item.From),
+			formatBillingTime(item.To),
+			formatCents(item.Amount),
+			formatCents(item.Tax),
+			formatCents(item.Total),
+			string(item.EntityType),
+			entityID,
+			item.EntityLabel,
+			strings.Join(item.Tags, ";"),
+			item.Reason,
+		})
+	}
+
+	if err := writer.WriteAll(rows); err != nil {
+		return fmt.Errorf("failed to write billing items: %w", err)
+	}
+
+	return nil
+}
+
+func billingSummaryRow(group string, summary BillingSummary) []string {
+	return []string{group, formatCents(summary.Amount), formatCents(summary.Tax), formatCents(summary.Total)}
+}
+
+func formatBillingTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+func formatCents(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
+
+// roundCents rounds the given amount to the nearest cent, as invoice amounts are
+// returned as float32 and summing them accumulates rounding errors.
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
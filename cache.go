@@ -0,0 +1,308 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheInvalidationRule describes which cached endpoints should be invalidated
+// when a mutating request against a matching endpoint succeeds.
+type CacheInvalidationRule struct {
+	// Prefix is matched against the path of the mutated endpoint (e.g. "linode/instances").
+	Prefix string
+
+	// Invalidates contains the cached endpoint prefixes to invalidate
+	// when a mutation matching Prefix succeeds (e.g. "regions/availability").
+	Invalidates []string
+}
+
+// defaultCacheInvalidationRules are applied to every new Client in addition to
+// the implicit invalidation of the mutated endpoint and its parents.
+var defaultCacheInvalidationRules = []CacheInvalidationRule{
+	// Provisioning resources consumes regional capacity
+	{Prefix: "linode/instances", Invalidates: []string{"regions/availability", "regions/*/availability"}},
+	{Prefix: "lke/clusters", Invalidates: []string{"regions/availability", "regions/*/availability"}},
+}
+
+// AddCacheInvalidationRule registers a rule which invalidates the cached endpoints
+// matching any of the given prefixes whenever a mutating request (POST, PUT or DELETE)
+// against an endpoint starting with mutationPrefix succeeds.
+// A "*" path segment in a prefix matches any single segment.
+func (c *Client) AddCacheInvalidationRule(mutationPrefix string, invalidates ...string) *Client {
+	c.cacheInvalidationRules = append(c.cacheInvalidationRules, CacheInvalidationRule{
+		Prefix:      mutationPrefix,
+		Invalidates: invalidates,
+	})
+
+	return c
+}
+
+// invalidateCacheForMutation removes all cached entries related to the given
+// mutated endpoint. An entry is related if its path is a parent or a child of the
+// mutated endpoint, or if it is matched by a registered CacheInvalidationRule.
+func (c *Client) invalidateCacheForMutation(method, endpoint string) {
+	if method == http.MethodGet || method == http.MethodHead {
+		return
+	}
+
+	mutated := normalizeCachePath(endpoint)
+	if mutated == "" {
+		return
+	}
+
+	prefixes := []string{mutated}
+
+	for _, rule := range c.cacheInvalidationRules {
+		if cachePathHasPrefix(mutated, normalizeCachePath(rule.Prefix)) {
+			prefixes = append(prefixes, rule.Invalidates...)
+		}
+	}
+
+	invalidation := cacheInvalidation{mutated: mutated, prefixes: prefixes}
+
+	// Keep the log locked while deleting entries, so that responses fetched before the
+	// mutation can't be cached in between
+	c.cacheInvalidations.lock.Lock()
+	defer c.cacheInvalidations.lock.Unlock()
+
+	c.cacheInvalidations.record(invalidation)
+
+	c.cachedEntryLock.Lock()
+	defer c.cachedEntryLock.Unlock()
+
+	for key := range c.cachedEntries {
+		if invalidation.matches(key) {
+			delete(c.cachedEntries, key)
+		}
+	}
+}
+
+// cacheInvalidation describes the cached entries invalidated by a single mutation.
+type cacheInvalidation struct {
+	// all is set when the whole cache was invalidated.
+	all bool
+
+	mutated  string
+	prefixes []string
+}
+
+// matches returns whether the cached entry with the given key is invalidated.
+func (i cacheInvalidation) matches(key string) bool {
+	if i.all {
+		return true
+	}
+
+	cached := normalizeCachePath(key)
+
+	// Mutating a resource invalidates any cached parent listings
+	if cachePathHasPrefix(i.mutated, cached) {
+		return true
+	}
+
+	for _, prefix := range i.prefixes {
+		if cachePathHasPrefix(cached, normalizeCachePath(prefix)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cacheInvalidationLog records the invalidations made while cached requests are in flight,
+// so that responses fetched before an invalidation aren't cached after it.
+type cacheInvalidationLog struct {
+	lock sync.Mutex
+
+	// generation is incremented by each recorded invalidation.
+	generation uint64
+	fetches    int
+	entries    []cacheInvalidationEntry
+}
+
+type cacheInvalidationEntry struct {
+	generation   uint64
+	invalidation cacheInvalidation
+}
+
+// record records the given invalidation. The caller must hold the log's lock.
+func (l *cacheInvalidationLog) record(invalidation cacheInvalidation) {
+	// Only fetches which are in flight can be affected
+	if l.fetches == 0 {
+		return
+	}
+
+	l.generation++
+	l.entries = append(l.entries, cacheInvalidationEntry{generation: l.generation, invalidation: invalidation})
+}
+
+// start registers a fetch of a cached response, returning the current generation.
+func (l *cacheInvalidationLog) start() uint64 {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.fetches++
+
+	return l.generation
+}
+
+// finish unregisters a fetch started at the given generation, calling store unless the fetched
+// entry was invalidated since the fetch started. Store is called while the log is locked.
+func (l *cacheInvalidationLog) finish(key string, started uint64, store func()) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.fetches--
+
+	invalidated := slices.ContainsFunc(l.entries, func(entry cacheInvalidationEntry) bool {
+		return entry.generation > started && entry.invalidation.matches(key)
+	})
+
+	if l.fetches == 0 {
+		l.entries = nil
+	}
+
+	if store != nil && !invalidated {
+		store()
+	}
+}
+
+// fetchCachedResponse calls fetch and caches its response under the given key,
+// unless the key is invalidated while the fetch is in progress.
+func fetchCachedResponse[T any](
+	client *Client,
+	key string,
+	expiry *time.Duration,
+	fetch func() (T, error),
+) (T, error) {
+	started := client.cacheInvalidations.start()
+
+	response, err := fetch()
+	if err != nil {
+		client.cacheInvalidations.finish(key, started, nil)
+		return response, err
+	}
+
+	client.cacheInvalidations.finish(key, started, func() {
+		client.addCachedResponse(key, response, expiry)
+	})
+
+	return response, nil
+}
+
+// normalizeCachePath strips the list options hash generated by generateListCacheURL,
+// any query string and surrounding slashes from the given endpoint.
+func normalizeCachePath(endpoint string) string {
+	if idx := strings.IndexAny(endpoint, ":?"); idx >= 0 {
+		endpoint = endpoint[:idx]
+	}
+
+	return strings.Trim(endpoint, "/")
+}
+
+// cachePathHasPrefix returns whether the given path starts with the given prefix
+// on a path segment boundary. A "*" segment in prefix matches any single segment.
+func cachePathHasPrefix(path, prefix string) bool {
+	if prefix == "" {
+		return false
+	}
+
+	pathSegments := strings.Split(path, "/")
+	prefixSegments := strings.Split(prefix, "/")
+
+	if len(prefixSegments) > len(pathSegments) {
+		return false
+	}
+
+	for i, segment := range prefixSegments {
+		if segment != "*" && segment != pathSegments[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// inflightCall is a single in-progress request shared between callers.
+type inflightCall struct {
+	done    chan struct{}
+	cancel  context.CancelFunc
+	waiters int
+	value   any
+	err     error
+}
+
+// inflightGroup coalesces concurrent calls sharing the same key
+// into a single execution.
+type inflightGroup struct {
+	lock  sync.Mutex
+	calls map[string]*inflightCall
+}
+
+// do executes fn, making sure only one execution is in-flight for the given key.
+// Callers arriving while an execution is in progress wait for it and receive its result.
+//
+// The execution isn't tied to any single caller, so fn receives a context which keeps the
+// values of the first caller's context and is only cancelled once every caller has stopped
+// waiting. Each caller stops waiting once its own context is done, without affecting the
+// other callers.
+func (g *inflightGroup) do(ctx context.Context, key string, fn func(ctx context.Context) (any, error)) (any, error) {
+	g.lock.Lock()
+
+	if g.calls == nil {
+		g.calls = make(map[string]*inflightCall)
+	}
+
+	call, ok := g.calls[key]
+	if !ok {
+		callCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+
+		call = &inflightCall{done: make(chan struct{}), cancel: cancel}
+		g.calls[key] = call
+
+		go func() {
+			defer cancel()
+
+			call.value, call.err = fn(callCtx)
+
+			g.lock.Lock()
+			g.forget(key, call)
+			g.lock.Unlock()
+
+			close(call.done)
+		}()
+	}
+
+	call.waiters++
+
+	g.lock.Unlock()
+
+	select {
+	case <-ctx.Done():
+		g.lock.Lock()
+		defer g.lock.Unlock()
+
+		call.waiters--
+
+		// Stop the execution once nobody is waiting for its result
+		if call.waiters == 0 {
+			call.cancel()
+			g.forget(key, call)
+		}
+
+		return nil, ctx.Err()
+	case <-call.done:
+		return call.value, call.err
+	}
+}
+
+// forget removes the given call unless it has already been replaced.
+// The caller must hold the group's lock.
+func (g *inflightGroup) forget(key string, call *inflightCall) {
+	if g.calls[key] == call {
+		delete(g.calls, key)
+	}
+}
@@ -0,0 +1,266 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache_InvalidatedOnMutation(t *testing.T) {
+	var getCount atomic.Int32
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/regions/us-east/availability":
+			getCount.Add(1)
+			_, _ = w.Write([]byte(`[{"region": "us-east", "plan": "g6-nanode-1", "available": true}]`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v4/linode/instances":
+			_, _ = w.Write([]byte(`{"id": 123}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	client := newTestClient(t, server.Client())
+	client.SetBaseURL(server.URL)
+
+	_, err := client.GetRegionAvailability(context.Background(), "us-east")
+	require.NoError(t, err)
+
+	_, err = client.GetRegionAvailability(context.Background(), "us-east")
+	require.NoError(t, err)
+	require.EqualValues(t, 1, getCount.Load(), "expected second call to be cached")
+
+	_, err = client.CreateInstance(context.Background(), InstanceCreateOptions{Region: "us-east"})
+	require.NoError(t, err)
+
+	_, err = client.GetRegionAvailability(context.Background(), "us-east")
+	require.NoError(t, err)
+	require.EqualValues(t, 2, getCount.Load(), "expected cache to be invalidated by the mutation")
+}
+
+func TestCache_InvalidationRules(t *testing.T) {
+	client := newTestClient(t, nil)
+	client.AddCacheInvalidationRule("foo/bar", "cool/*/stuff")
+
+	cached := []string{
+		"foo",
+		"foo:abc123",
+		"foo/bar/123/baz",
+		"foo/barbaz",
+		"cool/1/stuff",
+		"cool/1/other",
+		"linode/types",
+	}
+
+	for _, key := range cached {
+		client.addCachedResponse(key, key, nil)
+	}
+
+	client.invalidateCacheForMutation(http.MethodGet, "foo/bar")
+	require.Len(t, client.cachedEntries, len(cached), "GET requests should not invalidate the cache")
+
+	client.invalidateCacheForMutation(http.MethodPut, "/foo/bar/123")
+
+	remaining := make([]string, 0, len(client.cachedEntries))
+	for key := range client.cachedEntries {
+		remaining = append(remaining, key)
+	}
+
+	require.ElementsMatch(t, []string{"foo/barbaz", "cool/1/other", "linode/types"}, remaining)
+}
+
+func TestCache_CoalescesConcurrentRequests(t *testing.T) {
+	var getCount atomic.Int32
+
+	release := make(chan struct{})
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		getCount.Add(1)
+		<-release
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": [{"id": "g6-nanode-1"}], "page": 1, "pages": 1, "results": 1}`))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	client := newTestClient(t, server.Client())
+	client.SetBaseURL(server.URL)
+
+	const callers = 10
+
+	var wg sync.WaitGroup
+
+	results := make([][]LinodeType, callers)
+	errs := make([]error, callers)
+
+	for i := range callers {
+		wg.Go(func() {
+			results[i], errs[i] = client.ListTypes(context.Background(), nil)
+		})
+	}
+
+	// Give all callers a chance to join the in-flight request
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.EqualValues(t, 1, getCount.Load())
+
+	for i := range callers {
+		require.NoError(t, errs[i])
+		require.Len(t, results[i], 1)
+		require.Equal(t, "g6-nanode-1", results[i][0].ID)
+	}
+}
+
+func TestCache_CoalescedRequestSurvivesCancelledCaller(t *testing.T) {
+	var getCount atomic.Int32
+
+	release := make(chan struct{})
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		getCount.Add(1)
+		<-release
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": [{"id": "g6-nanode-1"}], "page": 1, "pages": 1, "results": 1}`))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	client := newTestClient(t, server.Client())
+	client.SetBaseURL(server.URL)
+
+	firstCtx, cancel := context.WithCancel(context.Background())
+
+	firstErr := make(chan error, 1)
+
+	go func() {
+		_, err := client.ListTypes(firstCtx, nil)
+		firstErr <- err
+	}()
+
+	// Wait for the first caller's request to be in flight before joining it
+	require.Eventually(t, func() bool { return getCount.Load() == 1 }, time.Second, time.Millisecond)
+
+	var (
+		result    []LinodeType
+		secondErr error
+		wg        sync.WaitGroup
+	)
+
+	wg.Go(func() {
+		result, secondErr = client.ListTypes(context.Background(), nil)
+	})
+
+	require.Eventually(t, func() bool { return inflightWaiters(&client) == 2 }, time.Second, time.Millisecond)
+
+	cancel()
+	require.ErrorIs(t, <-firstErr, context.Canceled)
+
+	close(release)
+	wg.Wait()
+
+	require.NoError(t, secondErr)
+	require.Len(t, result, 1)
+	require.EqualValues(t, 1, getCount.Load())
+}
+
+func TestCache_CancelledWhenNoCallersRemain(t *testing.T) {
+	requestCancelled := make(chan struct{})
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+		close(requestCancelled)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	client := newTestClient(t, server.Client())
+	client.SetBaseURL(server.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.ListTypes(ctx, nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	select {
+	case <-requestCancelled:
+	case <-time.After(time.Second):
+		t.Fatal("expected the coalesced request to be cancelled")
+	}
+}
+
+func TestCache_InFlightResponseNotCachedAfterInvalidation(t *testing.T) {
+	var getCount atomic.Int32
+
+	release := make(chan struct{})
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if getCount.Add(1) == 1 {
+			<-release
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": [{"id": "g6-nanode-1"}], "page": 1, "pages": 1, "results": 1}`))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	client := newTestClient(t, server.Client())
+	client.SetBaseURL(server.URL)
+
+	done := make(chan error, 1)
+
+	go func() {
+		_, err := client.ListTypes(context.Background(), nil)
+		done <- err
+	}()
+
+	require.Eventually(t, func() bool { return getCount.Load() == 1 }, time.Second, time.Millisecond)
+
+	// The mutation succeeds while the response is in flight
+	client.invalidateCacheForMutation(http.MethodPut, "linode/types/g6-nanode-1")
+
+	close(release)
+	require.NoError(t, <-done)
+
+	_, err := client.ListTypes(context.Background(), nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, getCount.Load(), "the stale response should not have been cached")
+
+	_, err = client.ListTypes(context.Background(), nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, getCount.Load(), "later responses should be cached")
+}
+
+// inflightWaiters returns the number of callers waiting on the client's in-flight requests.
+func inflightWaiters(client *Client) int {
+	client.inflightRequests.lock.Lock()
+	defer client.inflightRequests.lock.Unlock()
+
+	result := 0
+	for _, call := range client.inflightRequests.calls {
+		result += call.waiters
+	}
+
+	return result
+}
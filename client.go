@@ -18,6 +18,7 @@ import (
 	"reflect"
 	"regexp"
 	"runtime"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -107,17 +108,29 @@ type Client struct {
 	loadedProfile   string
 
 	configProfiles map[string]ConfigProfile
+	// The client settings overridden by the loaded config profile,
+	// which are restored before another profile is applied
+	profileOverrides *profileOverrides
 
 	// Fields for caching endpoint responses
 	shouldCache     bool
 	cacheExpiration time.Duration
 	cachedEntries   map[string]clientCacheEntry
 	cachedEntryLock *sync.RWMutex
+	// Rules used to invalidate cached entries after successful mutations
+	cacheInvalidationRules []CacheInvalidationRule
+	// Used to coalesce concurrent requests for the same cached endpoint
+	inflightRequests *inflightGroup
+	// Used to avoid caching responses fetched before an invalidation
+	cacheInvalidations *cacheInvalidationLog
+
 	logger          Logger
 	requestLog      func(*RequestLog) error
 	onBeforeRequest []func(*http.Request) error
 	onAfterResponse []func(*http.Response) error
 
+	credentialProvider CredentialProvider
+
 	retryConditionals []RetryConditional
 	retryMaxWaitTime  time.Duration
 	retryMinWaitTime  time.Duration
@@ -172,6 +185,9 @@ func NewClient(hc *http.Client) (client Client, err error) {
 	client.cacheExpiration = APIDefaultCacheExpiration
 	client.cachedEntries = make(map[string]clientCacheEntry)
 	client.cachedEntryLock = &sync.RWMutex{}
+	client.cacheInvalidationRules = slices.Clone(defaultCacheInvalidationRules)
+	client.inflightRequests = &inflightGroup{}
+	client.cacheInvalidations = &cacheInvalidationLog{}
 	client.configProfiles = make(map[string]ConfigProfile)
 
 	const (
@@ -220,6 +236,9 @@ func NewClient(hc *http.Client) (client Client, err error) {
 
 // NewClientFromEnv creates a Client and initializes it with values
 // from the LINODE_CONFIG file and the LINODE_TOKEN environment variable.
+//
+// The token is resolved using a CredentialChain of the LINODE_TOKEN environment variable
+// followed by the selected config profile, so rejected tokens are re-resolved from the same sources.
 func NewClientFromEnv(hc *http.Client) (*Client, error) {
 	client, err := NewClient(hc)
 	if err != nil {
@@ -227,37 +246,33 @@ func NewClientFromEnv(hc *http.Client) (*Client, error) {
 	}
 
 	// Users are expected to chain NewClient(...) and LoadConfig(...) to customize these options
-	configPath, err := resolveValidConfigPath()
+	configPath, configProfile, err := ConfigProfileCredentialProvider{}.resolve()
 	if err != nil {
 		return nil, err
 	}
 
-	// Populate the token from the environment.
-	// Tokens should be first priority to maintain backwards compatibility
-	if token, ok := os.LookupEnv(APIEnvVar); ok && token != "" {
-		client.SetToken(token)
-		return &client, nil
-	}
+	// Tokens from the environment should be first priority to maintain backwards compatibility
+	envProvider := EnvCredentialProvider{}
+	chain := NewCredentialChain(
+		envProvider,
+		ConfigProfileCredentialProvider{Path: configPath, Profile: configProfile},
+	)
 
-	if p, ok := os.LookupEnv(APIConfigEnvVar); ok {
-		configPath = p
-	} else if !ok && configPath == "" {
-		return nil, fmt.Errorf("no linode config file or token found")
+	creds, err := chain.Retrieve(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("no linode config file or token found: %w", err)
 	}
 
-	configProfile := DefaultConfigProfile
+	client.SetToken(creds.Token)
+	client.SetCredentialProvider(chain)
 
-	if p, ok := os.LookupEnv(APIConfigProfileEnvVar); ok {
-		configProfile = p
+	// The config file's other settings are only used when the token isn't from the environment
+	if _, err := envProvider.Retrieve(context.Background()); err == nil {
+		return &client, nil
 	}
 
 	client.selectedProfile = configProfile
 
-	// We should only load the config if the config file exists
-	if _, statErr := os.Stat(configPath); statErr != nil {
-		return nil, fmt.Errorf("error loading config file %s: %w", configPath, statErr)
-	}
-
 	err = client.preLoadConfig(configPath)
 
 	return &client, err
@@ -274,6 +289,10 @@ func (c *Client) SetUserAgent(ua string) *Client {
 type requestParams struct {
 	Body     *bytes.Reader
 	Response any
+	// ResponseHandler consumes the response body directly and takes
+	// priority over Response. This allows responses to be streamed
+	// rather than decoded in their entirety.
+	ResponseHandler func(io.Reader) error
 	// Headers are per-request headers that will be applied only to
 	// the individual request, not stored on the shared client state.
 	Headers http.Header
@@ -294,6 +313,17 @@ func (c *Client) SetRootCertificate(certPath string) error {
 		return fmt.Errorf("custom transport is not allowed with a custom root CA: %w", err)
 	}
 
+	// The HTTP client overridden by a config profile is no longer restored,
+	// so the certificate isn't lost when switching profiles
+	if c.profileOverrides != nil {
+		c.profileOverrides.httpClient = nil
+	}
+
+	return addRootCertificate(config, certPath)
+}
+
+// addRootCertificate adds the root certificate at the given path to the given TLS config.
+func addRootCertificate(config *tls.Config, certPath string) error {
 	if config.RootCAs == nil {
 		config.RootCAs = x509.NewCertPool()
 	}
@@ -414,6 +444,11 @@ func (c *Client) SetAPIVersion(apiVersion string) *Client {
 
 // InvalidateCache clears all cached responses for all endpoints.
 func (c *Client) InvalidateCache() {
+	c.cacheInvalidations.lock.Lock()
+	defer c.cacheInvalidations.lock.Unlock()
+
+	c.cacheInvalidations.record(cacheInvalidation{all: true})
+
 	c.cachedEntryLock.Lock()
 	defer c.cachedEntryLock.Unlock()
 
@@ -450,12 +485,22 @@ func (c *Client) UseCache(value bool) {
 // SetRetryMaxWaitTime sets the maximum delay before retrying a request.
 func (c *Client) SetRetryMaxWaitTime(maxWaitTime time.Duration) *Client {
 	c.retryMaxWaitTime = maxWaitTime
+
+	if c.profileOverrides != nil {
+		c.profileOverrides.retryMaxWait = nil
+	}
+
 	return c
 }
 
 // SetRetryWaitTime sets the default (minimum) delay before retrying a request.
 func (c *Client) SetRetryWaitTime(minWaitTime time.Duration) *Client {
 	c.retryMinWaitTime = minWaitTime
+
+	if c.profileOverrides != nil {
+		c.profileOverrides.retryMinWait = nil
+	}
+
 	return c
 }
 
@@ -469,6 +514,11 @@ func (c *Client) SetRetryAfter(callback RetryAfter) *Client {
 // SetRetryCount sets the maximum retry attempts before aborting.
 func (c *Client) SetRetryCount(count int) *Client {
 	c.retryCount = count
+
+	if c.profileOverrides != nil {
+		c.profileOverrides.retryCount = nil
+	}
+
 	return c
 }
 
@@ -512,15 +562,23 @@ func (c *Client) doRequest(ctx context.Context, method, endpoint string, params
 		req  *http.Request
 		resp *http.Response
 		err  error
+
+		credentialsRefreshed bool
 	)
 
-	for range c.retryCount {
+	attempts := c.retryCount
+
+	for attempt := 0; attempt < attempts; attempt++ {
 		// createRequest seeks params.Body back to the start, so it's safe to retry.
 		req, err = c.createRequest(ctx, method, endpoint, params)
 		if err != nil {
 			return err
 		}
 
+		if err = c.applyCredentials(req); err != nil {
+			return err
+		}
+
 		if paginationMutator != nil {
 			if mutErr := (*paginationMutator)(req); mutErr != nil {
 				return c.ErrorAndLogf("failed to mutate before request: %v", mutErr.Error())
@@ -551,7 +609,12 @@ func (c *Client) doRequest(ctx context.Context, method, endpoint string, params
 				resp = c.logResponse(resp, start, end)
 			}
 
-			if params.Response != nil {
+			switch {
+			case params.ResponseHandler != nil:
+				if err = params.ResponseHandler(resp.Body); err != nil {
+					return c.ErrorAndLogf("failed to decode response: %v", err.Error())
+				}
+			case params.Response != nil:
 				if err = c.decodeResponseBody(resp, params.Response); err != nil {
 					return err
 				}
@@ -571,10 +634,20 @@ func (c *Client) doRequest(ctx context.Context, method, endpoint string, params
 
 		if err == nil {
 			if err = processResponse(startTime, endTime); err == nil {
+				c.invalidateCacheForMutation(method, endpoint)
 				return nil
 			}
 		}
 
+		// Retry once with refreshed credentials if the current credentials were rejected.
+		// The refreshed attempt doesn't count against the retry budget.
+		if !credentialsRefreshed && c.invalidateCredentials(err) {
+			credentialsRefreshed = true
+			attempts++
+
+			continue
+		}
+
 		if !c.shouldRetry(resp, err) {
 			break
 		}
@@ -1019,25 +1092,12 @@ func (c *Client) preLoadConfig(configPath string) error {
 		log.Printf("[INFO] Loading profile from %s\n", configPath)
 	}
 
-	if err := c.LoadConfig(&LoadConfigOptions{
-		Path:            configPath,
-		SkipLoadProfile: true,
-	}); err != nil {
-		return err
-	}
-
-	// We don't want to load the profile until the user is actually making requests
-	c.OnBeforeRequest(func(_ *Request) error {
-		if c.loadedProfile != c.selectedProfile {
-			if err := c.UseProfile(c.selectedProfile); err != nil {
-				return err
-			}
-		}
-
-		return nil
+	// The profile is loaded immediately rather than before the first request,
+	// as switching profiles isn't safe while requests are in flight
+	return c.LoadConfig(&LoadConfigOptions{
+		Path:    configPath,
+		Profile: c.selectedProfile,
 	})
-
-	return nil
 }
 
 func copyBool(bPtr *bool) *bool {
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -97,17 +98,14 @@ func TestClient_NewFromEnv(t *testing.T) {
 		t.Fatalf("mismatched profile: %s != %s", client.selectedProfile, "cool")
 	}
 
-	if client.loadedProfile != "" {
-		t.Fatal("expected empty loaded profile")
-	}
-
-	if err := client.UseProfile("cool"); err != nil {
-		t.Fatal(err)
-	}
-
+	// The profile should be loaded immediately rather than by a request hook
 	if client.loadedProfile != "cool" {
 		t.Fatal("expected cool as loaded profile")
 	}
+
+	if len(client.onBeforeRequest) != 0 {
+		t.Fatal("expected no request hooks")
+	}
 }
 
 func TestClient_NewFromEnvToken(t *testing.T) {
@@ -121,6 +119,19 @@ func TestClient_NewFromEnvToken(t *testing.T) {
 	if client.header.Get("Authorization") != "Bearer blah" {
 		t.Fatal("token not found in auth header: blah")
 	}
+
+	if _, ok := client.credentialProvider.(*CredentialChain); !ok {
+		t.Fatal("expected credentials to be resolved using a credential chain")
+	}
+}
+
+func TestClient_NewFromEnvNoCredentials(t *testing.T) {
+	t.Setenv(APIEnvVar, "")
+	t.Setenv(APIConfigEnvVar, filepath.Join(t.TempDir(), "missing"))
+
+	if _, err := NewClientFromEnv(nil); !errors.Is(err, ErrNoCredentials) {
+		t.Fatalf("expected ErrNoCredentials, got %v", err)
+	}
 }
 
 func TestClient_UseURL(t *testing.T) {
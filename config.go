@@ -1,9 +1,16 @@
 package linodego
 
 import (
+	"crypto/tls"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"gopkg.in/ini.v1"
 )
@@ -21,6 +28,60 @@ type ConfigProfile struct {
 	APIToken   string `ini:"token"`
 	APIVersion string `ini:"api_version"`
 	APIURL     string `ini:"api_url"`
+
+	// DefaultRegion, DefaultType and DefaultImage are the default values
+	// used by the linode-cli when creating resources.
+	// They are informational only and aren't applied by the client.
+	DefaultRegion string `ini:"region"`
+	DefaultType   string `ini:"type"`
+	DefaultImage  string `ini:"image"`
+
+	// CAPath is the path to a CA certificate used to validate the API host.
+	CAPath string `ini:"ca_path"`
+
+	// RetryCount is the maximum number of retry attempts for a request.
+	RetryCount int `ini:"retry_count"`
+	// RetryMinWait is the minimum delay before retrying a request (e.g. "2s").
+	RetryMinWait time.Duration `ini:"retry_min_wait"`
+	// RetryMaxWait is the maximum delay before retrying a request (e.g. "30s").
+	RetryMaxWait time.Duration `ini:"retry_max_wait"`
+
+	// Timeout is the timeout for each HTTP request made by the client (e.g. "1m").
+	Timeout time.Duration `ini:"timeout"`
+}
+
+// iniValues returns the ini key-value pairs for the profile.
+// Empty values are included so that they can be cleared when writing the profile.
+func (p ConfigProfile) iniValues() [][2]string {
+	formatDuration := func(d time.Duration) string {
+		if d == 0 {
+			return ""
+		}
+
+		return d.String()
+	}
+
+	formatInt := func(i int) string {
+		if i == 0 {
+			return ""
+		}
+
+		return strconv.Itoa(i)
+	}
+
+	return [][2]string{
+		{"token", p.APIToken},
+		{"api_version", p.APIVersion},
+		{"api_url", p.APIURL},
+		{"region", p.DefaultRegion},
+		{"type", p.DefaultType},
+		{"image", p.DefaultImage},
+		{"ca_path", p.CAPath},
+		{"retry_count", formatInt(p.RetryCount)},
+		{"retry_min_wait", formatDuration(p.RetryMinWait)},
+		{"retry_max_wait", formatDuration(p.RetryMaxWait)},
+		{"timeout", formatDuration(p.Timeout)},
+	}
 }
 
 type LoadConfigOptions struct {
@@ -51,11 +112,30 @@ func (c *Client) LoadConfig(options *LoadConfigOptions) error {
 		}
 	}
 
-	cfg, err := ini.Load(path)
+	result, err := loadConfigProfiles(path)
 	if err != nil {
 		return err
 	}
 
+	c.configProfiles = result
+
+	if !options.SkipLoadProfile {
+		if err := c.UseProfile(profileOption); err != nil {
+			return fmt.Errorf("unable to use profile %s: %w", profileOption, err)
+		}
+	}
+
+	return nil
+}
+
+// loadConfigProfiles reads all profiles from the Linode config at the given path.
+// Values missing from a profile are inherited from the default profile.
+func loadConfigProfiles(path string) (map[string]ConfigProfile, error) {
+	cfg, err := ini.Load(path)
+	if err != nil {
+		return nil, err
+	}
+
 	defaultConfig := ConfigProfile{
 		APIToken:   "",
 		APIURL:     APIHost,
@@ -65,7 +145,7 @@ func (c *Client) LoadConfig(options *LoadConfigOptions) error {
 	if cfg.HasSection("default") {
 		err := cfg.Section("default").MapTo(&defaultConfig)
 		if err != nil {
-			return fmt.Errorf("failed to map default profile: %w", err)
+			return nil, fmt.Errorf("failed to map default profile: %w", err)
 		}
 	}
 
@@ -76,25 +156,55 @@ func (c *Client) LoadConfig(options *LoadConfigOptions) error {
 
 		f := defaultConfig
 		if err := profile.MapTo(&f); err != nil {
-			return fmt.Errorf("failed to map values: %w", err)
+			return nil, fmt.Errorf("failed to map values: %w", err)
 		}
 
 		result[name] = f
 	}
 
-	c.configProfiles = result
+	return result, nil
+}
 
-	if !options.SkipLoadProfile {
-		if err := c.UseProfile(profileOption); err != nil {
-			return fmt.Errorf("unable to use profile %s: %w", profileOption, err)
-		}
+// profileOverrides holds the values of the client settings overridden by the loaded
+// config profile, which are restored before another profile is applied.
+// A nil field indicates the setting wasn't overridden or has since been set by the user.
+type profileOverrides struct {
+	httpClient   *http.Client
+	retryCount   *int
+	retryMinWait *time.Duration
+	retryMaxWait *time.Duration
+}
+
+// restoreProfileOverrides restores the client settings overridden by the loaded config profile.
+func (c *Client) restoreProfileOverrides() {
+	if c.profileOverrides == nil {
+		return
 	}
 
-	return nil
+	if c.profileOverrides.httpClient != nil {
+		c.httpClient = c.profileOverrides.httpClient
+	}
+
+	if c.profileOverrides.retryCount != nil {
+		c.retryCount = *c.profileOverrides.retryCount
+	}
+
+	if c.profileOverrides.retryMinWait != nil {
+		c.retryMinWaitTime = *c.profileOverrides.retryMinWait
+	}
+
+	if c.profileOverrides.retryMaxWait != nil {
+		c.retryMaxWaitTime = *c.profileOverrides.retryMaxWait
+	}
+
+	c.profileOverrides = nil
 }
 
 // UseProfile switches client to use the specified profile.
 // The specified profile must be already be loaded using client.LoadConfig(...)
+//
+// Client settings overridden by the previously used profile are restored first,
+// except for settings which have since been changed using the client's setters.
 func (c *Client) UseProfile(name string) error {
 	name = strings.ToLower(name)
 
@@ -115,15 +225,279 @@ func (c *Client) UseProfile(name string) error {
 		return fmt.Errorf("unable to resolve linode_api_version for profile %s", name)
 	}
 
+	// The HTTP client is copied rather than modified, as it may be shared with other clients.
+	// It's built before restoring the previous profile's settings so that a failure leaves the client unchanged.
+	baseHTTPClient := c.httpClient
+	if c.profileOverrides != nil && c.profileOverrides.httpClient != nil {
+		baseHTTPClient = c.profileOverrides.httpClient
+	}
+
+	var httpClient *http.Client
+
+	if profile.Timeout > 0 || profile.CAPath != "" {
+		copied := *baseHTTPClient
+		httpClient = &copied
+
+		if profile.Timeout > 0 {
+			httpClient.Timeout = profile.Timeout
+		}
+	}
+
+	if profile.CAPath != "" {
+		transport, ok := httpClient.Transport.(*http.Transport)
+		if !ok {
+			return fmt.Errorf("custom transport is not allowed with a custom root CA for profile %s", name)
+		}
+
+		transport = transport.Clone()
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+
+		if err := addRootCertificate(transport.TLSClientConfig, profile.CAPath); err != nil {
+			return fmt.Errorf("failed to set root certificate for profile %s: %w", name, err)
+		}
+
+		httpClient.Transport = transport
+	}
+
+	c.restoreProfileOverrides()
+
+	overrides := &profileOverrides{}
+
+	if httpClient != nil {
+		overrides.httpClient = c.httpClient
+		c.httpClient = httpClient
+	}
+
+	if profile.RetryCount > 0 {
+		original := c.retryCount
+		overrides.retryCount = &original
+		c.retryCount = profile.RetryCount
+	}
+
+	if profile.RetryMinWait > 0 {
+		original := c.retryMinWaitTime
+		overrides.retryMinWait = &original
+		c.retryMinWaitTime = profile.RetryMinWait
+	}
+
+	if profile.RetryMaxWait > 0 {
+		original := c.retryMaxWaitTime
+		overrides.retryMaxWait = &original
+		c.retryMaxWaitTime = profile.RetryMaxWait
+	}
+
+	c.profileOverrides = overrides
+
 	c.SetToken(profile.APIToken)
 	c.SetBaseURL(profile.APIURL)
 	c.SetAPIVersion(profile.APIVersion)
+
 	c.selectedProfile = name
 	c.loadedProfile = name
 
 	return nil
 }
 
+// GetLoadedProfile returns the name and settings of the config profile
+// currently in use by the client. If no profile has been loaded, ok will be false.
+func (c *Client) GetLoadedProfile() (name string, profile ConfigProfile, ok bool) {
+	if c.loadedProfile == "" {
+		return "", ConfigProfile{}, false
+	}
+
+	profile, ok = c.configProfiles[c.loadedProfile]
+
+	return c.loadedProfile, profile, ok
+}
+
+// ConfigFile is an editable Linode config file.
+// Unlike LoadConfig(...), profiles read from a ConfigFile do not inherit
+// values from the default profile so that they can be written back as-is.
+type ConfigFile struct {
+	// Path is the path the config file will be saved to.
+	Path string
+
+	file *ini.File
+}
+
+// OpenConfigFile opens the Linode config file at the given path for editing.
+// If path is empty, the first existing path in DefaultConfigPaths is used,
+// falling back to the first entry in DefaultConfigPaths.
+// A missing file is treated as an empty config.
+func OpenConfigFile(path string) (*ConfigFile, error) {
+	if path == "" {
+		resolved, err := resolveValidConfigPath()
+		if err != nil {
+			return nil, err
+		}
+
+		if resolved == "" {
+			resolved, err = FormatConfigPath(DefaultConfigPaths[0])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		path = resolved
+	}
+
+	result := &ConfigFile{Path: path}
+
+	if _, err := os.Stat(path); err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to stat config file %s: %w", path, err)
+		}
+
+		result.file = ini.Empty()
+
+		return result, nil
+	}
+
+	file, err := ini.Load(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config file %s: %w", path, err)
+	}
+
+	result.file = file
+
+	return result, nil
+}
+
+// ProfileNames returns the names of all profiles in the config file.
+func (f *ConfigFile) ProfileNames() []string {
+	result := make([]string, 0)
+
+	for _, section := range f.file.Sections() {
+		// Values outside of any section aren't a profile
+		if section.Name() == ini.DefaultSection {
+			continue
+		}
+
+		result = append(result, section.Name())
+	}
+
+	return result
+}
+
+// GetProfile returns the profile with the given name as written in the config file.
+func (f *ConfigFile) GetProfile(name string) (*ConfigProfile, error) {
+	section := f.findSection(name)
+	if section == nil {
+		return nil, fmt.Errorf("profile %s does not exist", name)
+	}
+
+	var result ConfigProfile
+	if err := section.MapTo(&result); err != nil {
+		return nil, fmt.Errorf("failed to map profile %s: %w", name, err)
+	}
+
+	return &result, nil
+}
+
+// SetProfile creates or replaces the profile with the given name.
+// Keys not managed by ConfigProfile (e.g. linode-cli plugin settings) are preserved.
+func (f *ConfigFile) SetProfile(name string, profile ConfigProfile) error {
+	section := f.findSection(name)
+	if section == nil {
+		newSection, err := f.file.NewSection(strings.ToLower(name))
+		if err != nil {
+			return fmt.Errorf("failed to create profile %s: %w", name, err)
+		}
+
+		section = newSection
+	}
+
+	for _, kv := range profile.iniValues() {
+		if kv[1] == "" {
+			section.DeleteKey(kv[0])
+			continue
+		}
+
+		section.Key(kv[0]).SetValue(kv[1])
+	}
+
+	return nil
+}
+
+// DeleteProfile removes the profile with the given name.
+func (f *ConfigFile) DeleteProfile(name string) error {
+	section := f.findSection(name)
+	if section == nil {
+		return fmt.Errorf("profile %s does not exist", name)
+	}
+
+	f.file.DeleteSection(section.Name())
+
+	return nil
+}
+
+// Save writes the config file to its path.
+// The file is only readable and writable by the current user, and
+// is replaced atomically to avoid leaving a partially written config.
+func (f *ConfigFile) Save() error {
+	err := writeFileAtomic(f.Path, func(w io.Writer) error {
+		_, err := f.file.WriteTo(w)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save config file %s: %w", f.Path, err)
+	}
+
+	return nil
+}
+
+// writeFileAtomic writes a file only readable and writable by the current user,
+// replacing any existing file atomically to avoid leaving it partially written.
+func writeFileAtomic(path string, write func(w io.Writer) error) error {
+	dir := filepath.Dir(path)
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+
+	tmpFile, err := os.CreateTemp(dir, "."+filepath.Base(path)+"-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+
+	// This is a no-op if the file has been successfully renamed
+	defer os.Remove(tmpFile.Name())
+
+	if err = tmpFile.Chmod(0o600); err != nil {
+		tmpFile.Close()
+		return fmt.Errorf("failed to set file permissions: %w", err)
+	}
+
+	if err = write(tmpFile); err != nil {
+		tmpFile.Close()
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	if err = tmpFile.Close(); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return os.Rename(tmpFile.Name(), path)
+}
+
+func (f *ConfigFile) findSection(name string) *ini.Section {
+	for _, section := range f.file.Sections() {
+		// Values outside of any section aren't a profile
+		if section.Name() == ini.DefaultSection {
+			continue
+		}
+
+		// Profile names are written in lowercase, matching UseProfile(...)
+		if section.Name() == strings.ToLower(name) {
+			return section
+		}
+	}
+
+	return nil
+}
+
 func FormatConfigPath(path string) (string, error) {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
@@ -2,8 +2,15 @@ package linodego
 
 import (
 	"fmt"
+	"net/http"
 	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
 	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestConfig_LoadWithDefaults(t *testing.T) {
@@ -129,6 +136,208 @@ func TestConfig_NoDefaults(t *testing.T) {
 	}
 }
 
+func TestConfig_ExtendedProfileSettings(t *testing.T) {
+	hc := &http.Client{Timeout: 5 * time.Second}
+	client := newTestClient(t, hc)
+
+	file := createTestConfig(t, configExtendedSettings)
+
+	err := client.LoadConfig(&LoadConfigOptions{
+		Path:    file.Name(),
+		Profile: "cool",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, p, ok := client.GetLoadedProfile()
+	if !ok || name != "cool" {
+		t.Fatalf("expected cool to be the loaded profile, got %s", name)
+	}
+
+	expected := ConfigProfile{
+		APIToken:      "blah",
+		APIURL:        "api.cool.linode.com",
+		APIVersion:    "v4beta",
+		DefaultRegion: "us-east",
+		DefaultType:   "g6-standard-1",
+		DefaultImage:  "linode/debian12",
+		RetryCount:    5,
+		RetryMinWait:  2 * time.Second,
+		RetryMaxWait:  time.Minute,
+		Timeout:       30 * time.Second,
+	}
+
+	if !reflect.DeepEqual(p, expected) {
+		t.Fatalf("mismatched profile: %s", cmp.Diff(expected, p))
+	}
+
+	if client.retryCount != 5 || client.retryMinWaitTime != 2*time.Second || client.retryMaxWaitTime != time.Minute {
+		t.Fatalf("retry settings were not applied: %d %s %s", client.retryCount, client.retryMinWaitTime, client.retryMaxWaitTime)
+	}
+
+	if client.httpClient.Timeout != 30*time.Second {
+		t.Fatalf("mismatched timeout: %s != %s", client.httpClient.Timeout, 30*time.Second)
+	}
+
+	if hc.Timeout != 5*time.Second {
+		t.Fatalf("the caller's HTTP client was modified: %s", hc.Timeout)
+	}
+
+	// Settings of the previous profile should not carry over
+	if err := client.UseProfile("default"); err != nil {
+		t.Fatal(err)
+	}
+
+	if client.retryCount != DefaultRetryCount || client.retryMinWaitTime != APISecondsPerPoll*time.Second ||
+		client.retryMaxWaitTime != APIRetryMaxWaitTime {
+		t.Fatalf("retry settings were not reset: %d %s %s", client.retryCount, client.retryMinWaitTime, client.retryMaxWaitTime)
+	}
+
+	if client.httpClient.Timeout != 5*time.Second {
+		t.Fatalf("timeout was not reset: %s", client.httpClient.Timeout)
+	}
+}
+
+func TestConfig_UseProfileKeepsClientSettings(t *testing.T) {
+	client := newTestClient(t, &http.Client{Timeout: 5 * time.Second})
+
+	file := createTestConfig(t, configExtendedSettings)
+
+	if err := client.LoadConfig(&LoadConfigOptions{Path: file.Name(), Profile: "cool"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Settings changed after a profile is applied should not be undone by switching profiles
+	client.SetRetryCount(2)
+	client.SetRetryWaitTime(time.Second)
+
+	if err := client.UseProfile("default"); err != nil {
+		t.Fatal(err)
+	}
+
+	if client.retryCount != 2 || client.retryMinWaitTime != time.Second {
+		t.Fatalf("retry settings were reset: %d %s", client.retryCount, client.retryMinWaitTime)
+	}
+
+	if client.retryMaxWaitTime != APIRetryMaxWaitTime {
+		t.Fatalf("retry max wait time was not reset: %s", client.retryMaxWaitTime)
+	}
+
+	if client.httpClient.Timeout != 5*time.Second {
+		t.Fatalf("timeout was not reset: %s", client.httpClient.Timeout)
+	}
+}
+
+func TestConfigFile_IgnoresValuesOutsideProfiles(t *testing.T) {
+	file := createTestConfig(t, "token = toplevel\n\n[cool]\ntoken = mytoken\n")
+
+	config, err := OpenConfigFile(file.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(config.ProfileNames(), []string{"cool"}) {
+		t.Fatalf("mismatched profile names: %v", config.ProfileNames())
+	}
+
+	if _, err := config.GetProfile(DefaultConfigProfile); err == nil {
+		t.Fatal("expected values outside of any profile not to be the default profile")
+	}
+}
+
+func TestConfigFile_WriteProfiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "linode")
+
+	config, err := OpenConfigFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(config.ProfileNames()) != 0 {
+		t.Fatalf("expected no profiles, got %v", config.ProfileNames())
+	}
+
+	if err := config.SetProfile("default", ConfigProfile{APIToken: "blah", APIVersion: "v4beta"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := config.SetProfile("Cool", ConfigProfile{APIToken: "mytoken", DefaultRegion: "us-east", Timeout: time.Minute}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := config.SetProfile("removed", ConfigProfile{APIToken: "removed"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := config.DeleteProfile("removed"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := config.DeleteProfile("removed"); err == nil {
+		t.Fatal("expected error when deleting a missing profile")
+	}
+
+	if err := config.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if runtime.GOOS != "windows" && info.Mode().Perm() != 0o600 {
+		t.Fatalf("mismatched config file permissions: %s", info.Mode().Perm())
+	}
+
+	// Ensure the written config can be loaded by the client
+	client := newTestClient(t, nil)
+
+	if err := client.LoadConfig(&LoadConfigOptions{Path: path, Profile: "cool"}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, p, _ := client.GetLoadedProfile()
+
+	expected := ConfigProfile{
+		APIToken:      "mytoken",
+		APIURL:        APIHost,
+		APIVersion:    "v4beta",
+		DefaultRegion: "us-east",
+		Timeout:       time.Minute,
+	}
+
+	if !reflect.DeepEqual(p, expected) {
+		t.Fatalf("mismatched profile: %s", cmp.Diff(expected, p))
+	}
+
+	// Ensure updating a profile clears unset values and preserves unknown keys
+	config, err = OpenConfigFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config.file.Section("cool").Key("plugin-setting").SetValue("cool")
+
+	if err := config.SetProfile("cool", ConfigProfile{APIToken: "newtoken"}); err != nil {
+		t.Fatal(err)
+	}
+
+	updated, err := config.GetProfile("cool")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(*updated, ConfigProfile{APIToken: "newtoken"}) {
+		t.Fatalf("mismatched profile: %s", cmp.Diff(ConfigProfile{APIToken: "newtoken"}, *updated))
+	}
+
+	if config.file.Section("cool").Key("plugin-setting").String() != "cool" {
+		t.Fatal("expected unknown keys to be preserved")
+	}
+}
+
 func createTestConfig(t *testing.T, conf string) *os.File {
 	file, err := os.CreateTemp("", "linode")
 	if err != nil {
@@ -168,3 +377,19 @@ const configNoDefaults = `
 token = mytoken
 # Linodego default values are inherited here
 `
+
+const configExtendedSettings = `
+[default]
+token = blah
+api_url = api.cool.linode.com
+api_version = v4beta
+
+[cool]
+region = us-east
+type = g6-standard-1
+image = linode/debian12
+retry_count = 5
+retry_min_wait = 2s
+retry_max_wait = 1m
+timeout = 30s
+`
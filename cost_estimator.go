@@ -0,0 +1,489 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// CostLineItem is the estimated cost of a resource.
+type CostLineItem struct {
+	EntityType EntityType `json:"entity_type"`
+	ID         string     `json:"id"`
+	Label      string     `json:"label"`
+	Region     string     `json:"region,omitzero"`
+	Tags       []string   `json:"tags,omitzero"`
+
+	// Description describes what is priced, e.g. the resource's type.
+	Description string `json:"description"`
+
+	Cost CostEstimate `json:"cost"`
+
+	// Unpriced is the reason the resource couldn't be priced, e.g. its type has been retired.
+	// Unpriced items have a zero cost.
+	Unpriced string `json:"unpriced,omitzero"`
+}
+
+// CostReport is the estimated cost of the resources on an account.
+type CostReport struct {
+	Items []CostLineItem `json:"items"`
+	Total CostEstimate   `json:"total"`
+
+	// ByType, ByRegion and ByTag group the items' costs. Items with several tags are counted
+	// under each of them, so the totals by tag may exceed the report's total. Untagged items
+	// and account-level items without a region are grouped under the empty string.
+	ByType   map[EntityType]CostEstimate `json:"by_type"`
+	ByRegion map[string]CostEstimate     `json:"by_region"`
+	ByTag    map[string]CostEstimate     `json:"by_tag"`
+}
+
+func (r *CostReport) add(item CostLineItem) {
+	r.Items = append(r.Items, item)
+	r.Total = r.Total.Add(item.Cost)
+	r.ByType[item.EntityType] = r.ByType[item.EntityType].Add(item.Cost)
+	r.ByRegion[item.Region] = r.ByRegion[item.Region].Add(item.Cost)
+
+	if len(item.Tags) == 0 {
+		r.ByTag[""] = r.ByTag[""].Add(item.Cost)
+	}
+
+	for _, tag := range item.Tags {
+		r.ByTag[tag] = r.ByTag[tag].Add(item.Cost)
+	}
+}
+
+// CostChange is the estimated cost of a resource before and after a proposed change.
+type CostChange struct {
+	Before CostEstimate `json:"before"`
+	After  CostEstimate `json:"after"`
+}
+
+// Delta returns the change in cost, which is negative if the change is cheaper.
+func (c *CostChange) Delta() CostEstimate {
+	return c.After.Sub(c.Before)
+}
+
+// CostEstimator estimates the cost of the account's resources and of proposed changes
+// using the API's pricing endpoints, preferring region-specific prices. Prices are listed
+// once per estimator, so an estimator can be reused to price several changes.
+//
+// Estimates don't include Object Storage, whose pricing isn't exposed by the API, or
+// credits, promotions and taxes.
+type CostEstimator struct {
+	client *Client
+	prices *priceCatalog
+}
+
+// NewCostEstimator creates a new CostEstimator.
+func (c *Client) NewCostEstimator() *CostEstimator {
+	return &CostEstimator{client: c, prices: newPriceCatalog(c)}
+}
+
+// EstimateAccount estimates the cost of the account's instances, volumes, NodeBalancers,
+// LKE control planes, databases, reserved IP addresses and billable network transfer.
+//
+// Instances in LKE node pools are priced as instances, and their clusters are priced
+// by their control planes. Resources the API has no price for, e.g. instances of retired
+// types, are reported as unpriced items rather than failing the estimate.
+func (e *CostEstimator) EstimateAccount(ctx context.Context) (*CostReport, error) {
+	report := &CostReport{
+		Items:    make([]CostLineItem, 0),
+		ByType:   make(map[EntityType]CostEstimate),
+		ByRegion: make(map[string]CostEstimate),
+		ByTag:    make(map[string]CostEstimate),
+	}
+
+	for _, estimate := range []func(ctx context.Context, report *CostReport) error{
+		e.estimateInstances,
+		e.estimateVolumes,
+		e.estimateNodeBalancers,
+		e.estimateLKEClusters,
+		e.estimateDatabases,
+		e.estimateReservedIPs,
+		e.estimateTransfer,
+	} {
+		if err := estimate(ctx, report); err != nil {
+			return nil, err
+		}
+	}
+
+	return report, nil
+}
+
+func (e *CostEstimator) estimateInstances(ctx context.Context, report *CostReport) error {
+	instances, err := e.client.ListInstances(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list instances: %w", err)
+	}
+
+	for _, instance := range instances {
+		backups := instance.Backups != nil && instance.Backups.Enabled
+
+		cost, err := e.prices.instance(ctx, instance.Type, instance.Region, backups)
+
+		unpriced, err := unpricedReason(err)
+		if err != nil {
+			return err
+		}
+
+		description := instance.Type
+		if backups {
+			description += " with backups"
+		}
+
+		report.add(CostLineItem{
+			EntityType:  EntityLinode,
+			ID:          strconv.Itoa(instance.ID),
+			Label:       instance.Label,
+			Region:      instance.Region,
+			Tags:        instance.Tags,
+			Description: description,
+			Cost:        cost,
+			Unpriced:    unpriced,
+		})
+	}
+
+	return nil
+}
+
+func (e *CostEstimator) estimateVolumes(ctx context.Context, report *CostReport) error {
+	volumes, err := e.client.ListVolumes(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list volumes: %w", err)
+	}
+
+	for _, volume := range volumes {
+		cost, err := e.prices.volume(ctx, volume.Region, volume.Size)
+		if err != nil {
+			return err
+		}
+
+		report.add(CostLineItem{
+			EntityType:  EntityVolume,
+			ID:          strconv.Itoa(volume.ID),
+			Label:       volume.Label,
+			Region:      volume.Region,
+			Tags:        volume.Tags,
+			Description: fmt.Sprintf("%d GB", volume.Size),
+			Cost:        cost,
+		})
+	}
+
+	return nil
+}
+
+func (e *CostEstimator) estimateNodeBalancers(ctx context.Context, report *CostReport) error {
+	nodebalancers, err := e.client.ListNodeBalancers(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list NodeBalancers: %w", err)
+	}
+
+	for _, nodebalancer := range nodebalancers {
+		cost, err := e.prices.nodeBalancer(ctx, nodebalancer.Type, nodebalancer.Region)
+		if err != nil {
+			return err
+		}
+
+		label := ""
+		if nodebalancer.Label != nil {
+			label = *nodebalancer.Label
+		}
+
+		report.add(CostLineItem{
+			EntityType:  EntityNodebalancer,
+			ID:          strconv.Itoa(nodebalancer.ID),
+			Label:       label,
+			Region:      nodebalancer.Region,
+			Tags:        nodebalancer.Tags,
+			Description: "NodeBalancer",
+			Cost:        cost,
+		})
+	}
+
+	return nil
+}
+
+func (e *CostEstimator) estimateLKEClusters(ctx context.Context, report *CostReport) error {
+	clusters, err := e.client.ListLKEClusters(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list LKE clusters: %w", err)
+	}
+
+	for _, cluster := range clusters {
+		cost, err := e.prices.lkeControlPlane(ctx, cluster.Region, cluster.Tier, cluster.ControlPlane.HighAvailability)
+		if err != nil {
+			return err
+		}
+
+		description := "control plane"
+		if cluster.ControlPlane.HighAvailability {
+			description = "high availability control plane"
+		}
+
+		report.add(CostLineItem{
+			EntityType:  EntityLKECluster,
+			ID:          strconv.Itoa(cluster.ID),
+			Label:       cluster.Label,
+			Region:      cluster.Region,
+			Tags:        cluster.Tags,
+			Description: description,
+			Cost:        cost,
+		})
+	}
+
+	return nil
+}
+
+func (e *CostEstimator) estimateDatabases(ctx context.Context, report *CostReport) error {
+	mysqlDatabases, err := e.client.ListMySQLDatabases(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list MySQL databases: %w", err)
+	}
+
+	for _, database := range mysqlDatabases {
+		if err := e.addDatabase(ctx, report, "mysql", database.ID, database.Label, database.Region, database.Type, database.ClusterSize); err != nil {
+			return err
+		}
+	}
+
+	postgresDatabases, err := e.client.ListPostgresDatabases(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list PostgreSQL databases: %w", err)
+	}
+
+	for _, database := range postgresDatabases {
+		if err := e.addDatabase(ctx, report, "postgresql", database.ID, database.Label, database.Region, database.Type, database.ClusterSize); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *CostEstimator) addDatabase(
+	ctx context.Context,
+	report *CostReport,
+	engine string,
+	id int,
+	label, region, typeID string,
+	clusterSize int,
+) error {
+	cost, err := e.prices.database(ctx, engine, typeID, clusterSize)
+
+	unpriced, err := unpricedReason(err)
+	if err != nil {
+		return err
+	}
+
+	report.add(CostLineItem{
+		EntityType:  EntityDatabase,
+		ID:          strconv.Itoa(id),
+		Label:       label,
+		Region:      region,
+		Description: fmt.Sprintf("%s %s x%d", engine, typeID, max(clusterSize, 1)),
+		Cost:        cost,
+		Unpriced:    unpriced,
+	})
+
+	return nil
+}
+
+func (e *CostEstimator) estimateReservedIPs(ctx context.Context, report *CostReport) error {
+	ips, err := e.client.ListReservedIPAddresses(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list reserved IP addresses: %w", err)
+	}
+
+	for _, ip := range ips {
+		cost, err := e.prices.reservedIP(ctx, ip.Region)
+		if err != nil {
+			return err
+		}
+
+		report.add(CostLineItem{
+			EntityType:  EntityIPAddress,
+			ID:          ip.Address,
+			Label:       ip.Address,
+			Region:      ip.Region,
+			Tags:        ip.Tags,
+			Description: "reserved IP address",
+			Cost:        cost,
+		})
+	}
+
+	return nil
+}
+
+func (e *CostEstimator) estimateTransfer(ctx context.Context, report *CostReport) error {
+	transfer, err := e.client.GetAccountTransfer(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get account transfer: %w", err)
+	}
+
+	if transfer.Billable <= 0 {
+		return nil
+	}
+
+	cost, err := e.prices.networkTransfer(ctx, transfer.Billable)
+	if err != nil {
+		return err
+	}
+
+	report.add(CostLineItem{
+		EntityType:  EntityAccount,
+		ID:          "network_transfer",
+		Label:       "network transfer",
+		Description: fmt.Sprintf("%d GB billable network transfer", transfer.Billable),
+		Cost:        cost,
+	})
+
+	return nil
+}
+
+// EstimateInstanceCreate estimates the cost of creating an instance with the given options,
+// including backups if enabled.
+func (e *CostEstimator) EstimateInstanceCreate(ctx context.Context, opts InstanceCreateOptions) (CostEstimate, error) {
+	return e.prices.instance(ctx, opts.Type, opts.Region, opts.BackupsEnabled)
+}
+
+// EstimateVolumeCreate estimates the cost of creating a volume with the given options.
+// Volumes created without a size are priced at the default size of 20 GB.
+func (e *CostEstimator) EstimateVolumeCreate(ctx context.Context, opts VolumeCreateOptions) (CostEstimate, error) {
+	size := opts.Size
+	if size == 0 {
+		size = 20
+	}
+
+	return e.prices.volume(ctx, opts.Region, size)
+}
+
+// EstimateNodeBalancerCreate estimates the cost of creating a NodeBalancer with the given options.
+func (e *CostEstimator) EstimateNodeBalancerCreate(ctx context.Context, opts NodeBalancerCreateOptions) (CostEstimate, error) {
+	return e.prices.nodeBalancer(ctx, opts.Type, opts.Region)
+}
+
+// EstimateLKEClusterCreate estimates the cost of creating an LKE cluster with the given options,
+// including its node pools and control plane.
+func (e *CostEstimator) EstimateLKEClusterCreate(ctx context.Context, opts LKEClusterCreateOptions) (CostEstimate, error) {
+	highAvailability := opts.ControlPlane != nil && opts.ControlPlane.HighAvailability != nil &&
+		*opts.ControlPlane.HighAvailability
+
+	result, err := e.prices.lkeControlPlane(ctx, opts.Region, opts.Tier, highAvailability)
+	if err != nil {
+		return CostEstimate{}, err
+	}
+
+	for _, pool := range opts.NodePools {
+		cost, err := e.prices.instance(ctx, pool.Type, opts.Region, false)
+		if err != nil {
+			return CostEstimate{}, err
+		}
+
+		result = result.Add(cost.Scale(float64(pool.Count)))
+	}
+
+	return result, nil
+}
+
+// EstimateMySQLCreate estimates the cost of creating a MySQL database with the given options.
+func (e *CostEstimator) EstimateMySQLCreate(ctx context.Context, opts MySQLCreateOptions) (CostEstimate, error) {
+	return e.prices.database(ctx, "mysql", opts.Type, opts.ClusterSize)
+}
+
+// EstimatePostgresCreate estimates the cost of creating a PostgreSQL database with the given options.
+func (e *CostEstimator) EstimatePostgresCreate(ctx context.Context, opts PostgresCreateOptions) (CostEstimate, error) {
+	return e.prices.database(ctx, "postgresql", opts.Type, opts.ClusterSize)
+}
+
+// EstimateInstanceResize estimates the change in cost of resizing an instance to the given type.
+func (e *CostEstimator) EstimateInstanceResize(ctx context.Context, linodeID int, typeID string) (*CostChange, error) {
+	instance, err := e.client.GetInstance(ctx, linodeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get instance %d: %w", linodeID, err)
+	}
+
+	backups := instance.Backups != nil && instance.Backups.Enabled
+
+	before, err := e.prices.instance(ctx, instance.Type, instance.Region, backups)
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := e.prices.instance(ctx, typeID, instance.Region, backups)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CostChange{Before: before, After: after}, nil
+}
+
+// EstimateLKENodePoolResize estimates the change in cost of resizing an LKE node pool
+// to the given number of nodes.
+func (e *CostEstimator) EstimateLKENodePoolResize(ctx context.Context, clusterID, poolID, count int) (*CostChange, error) {
+	cluster, err := e.client.GetLKECluster(ctx, clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get LKE cluster %d: %w", clusterID, err)
+	}
+
+	pool, err := e.client.GetLKENodePool(ctx, clusterID, poolID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get LKE node pool %d: %w", poolID, err)
+	}
+
+	node, err := e.prices.instance(ctx, pool.Type, cluster.Region, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CostChange{Before: node.Scale(float64(pool.Count)), After: node.Scale(float64(count))}, nil
+}
+
+// EstimateMySQLUpdate estimates the change in cost of updating a MySQL database with the given
+// options. Only the type and cluster size affect the cost.
+func (e *CostEstimator) EstimateMySQLUpdate(ctx context.Context, databaseID int, opts MySQLUpdateOptions) (*CostChange, error) {
+	database, err := e.client.GetMySQLDatabase(ctx, databaseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get MySQL database %d: %w", databaseID, err)
+	}
+
+	return e.databaseChange(ctx, "mysql", database.Type, database.ClusterSize, opts.Type, opts.ClusterSize)
+}
+
+// EstimatePostgresUpdate estimates the change in cost of updating a PostgreSQL database with the
+// given options. Only the type and cluster size affect the cost.
+func (e *CostEstimator) EstimatePostgresUpdate(ctx context.Context, databaseID int, opts PostgresUpdateOptions) (*CostChange, error) {
+	database, err := e.client.GetPostgresDatabase(ctx, databaseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PostgreSQL database %d: %w", databaseID, err)
+	}
+
+	return e.databaseChange(ctx, "postgresql", database.Type, database.ClusterSize, opts.Type, opts.ClusterSize)
+}
+
+func (e *CostEstimator) databaseChange(
+	ctx context.Context,
+	engine, typeID string,
+	clusterSize int,
+	newTypeID string,
+	newClusterSize int,
+) (*CostChange, error) {
+	before, err := e.prices.database(ctx, engine, typeID, clusterSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if newTypeID == "" {
+		newTypeID = typeID
+	}
+
+	if newClusterSize == 0 {
+		newClusterSize = clusterSize
+	}
+
+	after, err := e.prices.database(ctx, engine, newTypeID, newClusterSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CostChange{Before: before, After: after}, nil
+}
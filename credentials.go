@@ -0,0 +1,441 @@
+package linodego
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultCredentialExpiryWindow is the default duration before a credential's expiry
+	// at which it will be considered expired and refreshed.
+	DefaultCredentialExpiryWindow = time.Minute
+
+	// DefaultCredentialCommandTimeout is the default timeout for
+	// credential commands run by CommandCredentialProvider.
+	DefaultCredentialCommandTimeout = time.Minute
+)
+
+// ErrNoCredentials is returned by a CredentialProvider when it has no credentials to provide.
+// A CredentialChain will continue to the next provider when this error is returned.
+var ErrNoCredentials = errors.New("no credentials found")
+
+// Credentials represents a Linode API token resolved by a CredentialProvider.
+type Credentials struct {
+	// Token is the Linode API token.
+	Token string
+
+	// Expiry is the time at which the token expires.
+	// A zero value indicates the token does not expire.
+	Expiry time.Time
+
+	// Source is a human-readable description of where the token was resolved from.
+	Source string
+}
+
+// Expired returns whether the credentials have expired or will expire within the given window.
+func (c Credentials) Expired(window time.Duration) bool {
+	if c.Expiry.IsZero() {
+		return false
+	}
+
+	return time.Now().Add(window).After(c.Expiry)
+}
+
+// CredentialProvider resolves the credentials used to authenticate with the Linode API.
+type CredentialProvider interface {
+	Retrieve(ctx context.Context) (*Credentials, error)
+}
+
+// CredentialInvalidator is implemented by CredentialProviders that cache credentials.
+// Invalidate is called by the Client when the API rejects the current credentials
+// so that they will be refreshed on the next request.
+type CredentialInvalidator interface {
+	Invalidate()
+}
+
+// EnvCredentialProvider resolves a token from an environment variable.
+type EnvCredentialProvider struct {
+	// VarName is the environment variable to read the token from.
+	// Defaults to LINODE_TOKEN.
+	VarName string
+}
+
+// Retrieve implements CredentialProvider.
+func (p EnvCredentialProvider) Retrieve(_ context.Context) (*Credentials, error) {
+	varName := p.VarName
+	if varName == "" {
+		varName = APIEnvVar
+	}
+
+	token, ok := os.LookupEnv(varName)
+	if !ok || token == "" {
+		return nil, fmt.Errorf("environment variable %s is not set: %w", varName, ErrNoCredentials)
+	}
+
+	return &Credentials{Token: token, Source: "env:" + varName}, nil
+}
+
+// ConfigProfileCredentialProvider resolves a token from a profile in a Linode config file.
+type ConfigProfileCredentialProvider struct {
+	// Path is the path of the Linode config file.
+	// Defaults to LINODE_CONFIG or the first existing DefaultConfigPaths entry.
+	Path string
+
+	// Profile is the name of the profile to use.
+	// Defaults to LINODE_PROFILE or DefaultConfigProfile.
+	Profile string
+}
+
+// Retrieve implements CredentialProvider.
+func (p ConfigProfileCredentialProvider) Retrieve(_ context.Context) (*Credentials, error) {
+	path, profileName, err := p.resolve()
+	if err != nil {
+		return nil, err
+	}
+
+	if path == "" {
+		return nil, fmt.Errorf("no linode config file found: %w", ErrNoCredentials)
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("linode config file %s not found: %w", path, ErrNoCredentials)
+	}
+
+	profiles, err := loadConfigProfiles(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config file %s: %w", path, err)
+	}
+
+	profile, ok := profiles[profileName]
+	if !ok || profile.APIToken == "" {
+		return nil, fmt.Errorf("no token found for profile %s: %w", profileName, ErrNoCredentials)
+	}
+
+	return &Credentials{Token: profile.APIToken, Source: fmt.Sprintf("config:%s[%s]", path, profileName)}, nil
+}
+
+func (p ConfigProfileCredentialProvider) resolve() (string, string, error) {
+	path := p.Path
+	if path == "" {
+		if envPath, ok := os.LookupEnv(APIConfigEnvVar); ok {
+			path = envPath
+		} else {
+			defaultPath, err := resolveValidConfigPath()
+			if err != nil {
+				return "", "", err
+			}
+
+			path = defaultPath
+		}
+	}
+
+	profile := p.Profile
+	if profile == "" {
+		profile = DefaultConfigProfile
+
+		if envProfile, ok := os.LookupEnv(APIConfigProfileEnvVar); ok {
+			profile = envProfile
+		}
+	}
+
+	return path, strings.ToLower(profile), nil
+}
+
+// FileCredentialProvider resolves a token from a file containing only the token.
+// The file must not be accessible by the group or other users unless
+// AllowInsecurePermissions is set.
+type FileCredentialProvider struct {
+	// Path is the path of the file containing the token.
+	Path string
+
+	// AllowInsecurePermissions disables the file permission checks.
+	AllowInsecurePermissions bool
+}
+
+// Retrieve implements CredentialProvider.
+func (p FileCredentialProvider) Retrieve(_ context.Context) (*Credentials, error) {
+	path := filepath.Clean(p.Path)
+
+	info, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("token file %s not found: %w", path, ErrNoCredentials)
+		}
+
+		return nil, fmt.Errorf("failed to stat token file %s: %w", path, err)
+	}
+
+	// Windows does not support Unix permission bits
+	if !p.AllowInsecurePermissions && runtime.GOOS != "windows" && info.Mode().Perm()&0o077 != 0 {
+		return nil, fmt.Errorf(
+			"token file %s has insecure permissions %s, expected no group or other access",
+			path, info.Mode().Perm(),
+		)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token file %s: %w", path, err)
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return nil, fmt.Errorf("token file %s is empty: %w", path, ErrNoCredentials)
+	}
+
+	return &Credentials{Token: token, Source: "file:" + path}, nil
+}
+
+// CommandCredentialProvider resolves a token by running an external command,
+// similar to the AWS `credential_process` setting.
+//
+// The command must write either the raw token or a JSON object to stdout:
+//
+//	{"token": "...", "expiry": "2006-01-02T15:04:05Z"}
+//
+// The expiry field is optional and must be formatted as RFC 3339.
+type CommandCredentialProvider struct {
+	// Command is the name or path of the command to run.
+	Command string
+
+	// Args are the arguments passed to the command.
+	Args []string
+
+	// Timeout is the maximum duration the command may run for.
+	// Defaults to DefaultCredentialCommandTimeout.
+	Timeout time.Duration
+}
+
+type commandCredentialOutput struct {
+	Token  string    `json:"token"`
+	Expiry time.Time `json:"expiry"`
+}
+
+// Retrieve implements CredentialProvider.
+func (p CommandCredentialProvider) Retrieve(ctx context.Context) (*Credentials, error) {
+	if p.Command == "" {
+		return nil, fmt.Errorf("no credential command configured: %w", ErrNoCredentials)
+	}
+
+	timeout := p.Timeout
+	if timeout <= 0 {
+		timeout = DefaultCredentialCommandTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, p.Command, p.Args...) //#nosec G204 // the command is explicitly configured by the user
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("credential command %s failed: %w: %s", p.Command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	output := bytes.TrimSpace(stdout.Bytes())
+
+	result := &Credentials{Source: "command:" + p.Command}
+
+	if bytes.HasPrefix(output, []byte("{")) {
+		var parsed commandCredentialOutput
+		if err := json.Unmarshal(output, &parsed); err != nil {
+			return nil, fmt.Errorf("failed to parse credential command output: %w", err)
+		}
+
+		result.Token = parsed.Token
+		result.Expiry = parsed.Expiry
+	} else {
+		result.Token = string(output)
+	}
+
+	if result.Token == "" {
+		return nil, fmt.Errorf("credential command %s returned no token", p.Command)
+	}
+
+	return result, nil
+}
+
+// CredentialChain resolves credentials from the first provider in the chain which
+// returns them. Providers returning ErrNoCredentials are skipped, any other error
+// stops the chain.
+//
+// Resolved credentials are cached until they expire (minus ExpiryWindow)
+// or Invalidate is called.
+type CredentialChain struct {
+	Providers []CredentialProvider
+
+	// ExpiryWindow is the duration before expiry at which cached credentials are refreshed.
+	// Defaults to DefaultCredentialExpiryWindow.
+	ExpiryWindow time.Duration
+
+	lock   sync.Mutex
+	cached *Credentials
+
+	// generation is incremented by Invalidate so that credentials resolved
+	// concurrently with an invalidation aren't cached.
+	generation int
+	inflight   inflightGroup
+}
+
+// NewCredentialChain creates a new CredentialChain using the given providers.
+func NewCredentialChain(providers ...CredentialProvider) *CredentialChain {
+	return &CredentialChain{
+		Providers:    providers,
+		ExpiryWindow: DefaultCredentialExpiryWindow,
+	}
+}
+
+// NewDefaultCredentialChain creates a new CredentialChain matching the
+// token resolution order of NewClientFromEnv: the LINODE_TOKEN environment
+// variable followed by the selected Linode config profile.
+func NewDefaultCredentialChain() *CredentialChain {
+	return NewCredentialChain(
+		EnvCredentialProvider{},
+		ConfigProfileCredentialProvider{},
+	)
+}
+
+// Retrieve implements CredentialProvider.
+//
+// The providers are called without holding the chain's lock, so slow providers such as
+// credential commands don't block callers using cached credentials. Concurrent callers
+// without cached credentials share a single resolution.
+func (c *CredentialChain) Retrieve(ctx context.Context) (*Credentials, error) {
+	c.lock.Lock()
+
+	if c.cached != nil && !c.cached.Expired(c.ExpiryWindow) {
+		result := *c.cached
+		c.lock.Unlock()
+
+		return &result, nil
+	}
+
+	c.cached = nil
+	generation := c.generation
+	c.lock.Unlock()
+
+	// Resolutions started before an invalidation aren't shared with later callers,
+	// as they may return the credentials which were just rejected
+	resolved, err := c.inflight.do(ctx, strconv.Itoa(generation), func(ctx context.Context) (any, error) {
+		creds, err := c.resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		c.lock.Lock()
+		if c.generation == generation {
+			c.cached = creds
+		}
+		c.lock.Unlock()
+
+		return creds, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := *resolved.(*Credentials)
+
+	return &result, nil
+}
+
+// resolve returns the credentials of the first provider in the chain which returns them.
+func (c *CredentialChain) resolve(ctx context.Context) (*Credentials, error) {
+	errs := make([]error, 0, len(c.Providers))
+
+	for _, provider := range c.Providers {
+		creds, err := provider.Retrieve(ctx)
+		if err != nil {
+			if errors.Is(err, ErrNoCredentials) {
+				errs = append(errs, err)
+				continue
+			}
+
+			return nil, err
+		}
+
+		return creds, nil
+	}
+
+	if len(errs) == 0 {
+		return nil, ErrNoCredentials
+	}
+
+	return nil, fmt.Errorf("failed to resolve credentials: %w", errors.Join(errs...))
+}
+
+// Invalidate implements CredentialInvalidator.
+func (c *CredentialChain) Invalidate() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.cached = nil
+	c.generation++
+
+	for _, provider := range c.Providers {
+		if invalidator, ok := provider.(CredentialInvalidator); ok {
+			invalidator.Invalidate()
+		}
+	}
+}
+
+// SetCredentialProvider sets the CredentialProvider used to resolve the API token
+// for each request. Credentials resolved by the provider take priority over tokens
+// set using SetToken(...) or a config profile.
+//
+// If the API responds with 401 Unauthorized and the provider implements
+// CredentialInvalidator, the credentials are invalidated and the request is retried once.
+func (c *Client) SetCredentialProvider(provider CredentialProvider) *Client {
+	c.credentialProvider = provider
+	return c
+}
+
+// applyCredentials sets the Authorization header of the given request
+// using the client's CredentialProvider, if configured.
+func (c *Client) applyCredentials(req *http.Request) error {
+	if c.credentialProvider == nil {
+		return nil
+	}
+
+	creds, err := c.credentialProvider.Retrieve(req.Context())
+	if err != nil {
+		return c.ErrorAndLogf("failed to retrieve credentials: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+creds.Token)
+
+	return nil
+}
+
+// invalidateCredentials invalidates the client's cached credentials if the given
+// error indicates they were rejected by the API. It returns whether the credentials
+// were invalidated and the request should be retried.
+func (c *Client) invalidateCredentials(err error) bool {
+	if !ErrHasStatus(err, http.StatusUnauthorized) {
+		return false
+	}
+
+	invalidator, ok := c.credentialProvider.(CredentialInvalidator)
+	if !ok {
+		return false
+	}
+
+	invalidator.Invalidate()
+
+	return true
+}
@@ -0,0 +1,240 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testCredentialProvider struct {
+	tokens []string
+	calls  int
+}
+
+func (p *testCredentialProvider) Retrieve(_ context.Context) (*Credentials, error) {
+	if p.calls >= len(p.tokens) {
+		return nil, ErrNoCredentials
+	}
+
+	token := p.tokens[p.calls]
+	p.calls++
+
+	return &Credentials{Token: token, Expiry: time.Now().Add(time.Hour)}, nil
+}
+
+func TestCredentials_EnvProvider(t *testing.T) {
+	t.Setenv("LINODE_TEST_TOKEN", "")
+
+	_, err := EnvCredentialProvider{VarName: "LINODE_TEST_TOKEN"}.Retrieve(context.Background())
+	require.ErrorIs(t, err, ErrNoCredentials)
+
+	t.Setenv("LINODE_TEST_TOKEN", "mytoken")
+
+	creds, err := EnvCredentialProvider{VarName: "LINODE_TEST_TOKEN"}.Retrieve(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "mytoken", creds.Token)
+}
+
+func TestCredentials_ConfigProfileProvider(t *testing.T) {
+	file := createTestConfig(t, configOverrideDefaults)
+
+	creds, err := ConfigProfileCredentialProvider{Path: file.Name(), Profile: "cool"}.Retrieve(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "blah", creds.Token)
+
+	_, err = ConfigProfileCredentialProvider{Path: file.Name(), Profile: "missing"}.Retrieve(context.Background())
+	require.ErrorIs(t, err, ErrNoCredentials)
+}
+
+func TestCredentials_FileProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+
+	_, err := FileCredentialProvider{Path: path}.Retrieve(context.Background())
+	require.ErrorIs(t, err, ErrNoCredentials)
+
+	require.NoError(t, os.WriteFile(path, []byte("mytoken\n"), 0o600))
+
+	creds, err := FileCredentialProvider{Path: path}.Retrieve(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "mytoken", creds.Token)
+
+	if runtime.GOOS == "windows" {
+		return
+	}
+
+	require.NoError(t, os.Chmod(path, 0o644))
+
+	_, err = FileCredentialProvider{Path: path}.Retrieve(context.Background())
+	require.ErrorContains(t, err, "insecure permissions")
+
+	_, err = FileCredentialProvider{Path: path, AllowInsecurePermissions: true}.Retrieve(context.Background())
+	require.NoError(t, err)
+}
+
+func TestCredentials_CommandProvider(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+
+	creds, err := CommandCredentialProvider{
+		Command: "sh",
+		Args:    []string{"-c", "echo mytoken"},
+	}.Retrieve(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "mytoken", creds.Token)
+	require.True(t, creds.Expiry.IsZero())
+
+	creds, err = CommandCredentialProvider{
+		Command: "sh",
+		Args:    []string{"-c", `echo '{"token": "mytoken", "expiry": "2030-01-02T15:04:05Z"}'`},
+	}.Retrieve(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "mytoken", creds.Token)
+	require.Equal(t, time.Date(2030, 1, 2, 15, 4, 5, 0, time.UTC), creds.Expiry)
+
+	_, err = CommandCredentialProvider{
+		Command: "sh",
+		Args:    []string{"-c", "echo failed >&2; exit 1"},
+	}.Retrieve(context.Background())
+	require.ErrorContains(t, err, "failed")
+}
+
+func TestCredentials_Chain(t *testing.T) {
+	provider := &testCredentialProvider{tokens: []string{"first", "second"}}
+
+	chain := NewCredentialChain(
+		EnvCredentialProvider{VarName: "LINODE_TEST_MISSING_TOKEN"},
+		provider,
+	)
+
+	creds, err := chain.Retrieve(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "first", creds.Token)
+
+	// Cached credentials should be returned until invalidated
+	creds, err = chain.Retrieve(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "first", creds.Token)
+	require.Equal(t, 1, provider.calls)
+
+	chain.Invalidate()
+
+	creds, err = chain.Retrieve(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "second", creds.Token)
+
+	chain.Invalidate()
+
+	_, err = chain.Retrieve(context.Background())
+	require.True(t, errors.Is(err, ErrNoCredentials))
+}
+
+func TestCredentials_ClientRefreshOnUnauthorized(t *testing.T) {
+	var requestCount atomic.Int32
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		requestCount.Add(1)
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Header.Get("Authorization") != "Bearer second" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Invalid Token"}]}`))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"message": "success"}`))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	client := newTestClient(t, server.Client())
+	client.SetBaseURL(server.URL)
+	client.SetCredentialProvider(NewCredentialChain(&testCredentialProvider{tokens: []string{"first", "second"}}))
+
+	params := requestParams{
+		Response: &map[string]string{},
+	}
+
+	require.NoError(t, client.doRequest(context.Background(), http.MethodGet, "/foo/bar", params, nil))
+	require.EqualValues(t, 2, requestCount.Load())
+
+	// Credentials should only be refreshed once per request
+	client.SetCredentialProvider(NewCredentialChain(&testCredentialProvider{tokens: []string{"first", "third", "fourth"}}))
+
+	err := client.doRequest(context.Background(), http.MethodGet, "/foo/bar", params, nil)
+	require.True(t, ErrHasStatus(err, http.StatusUnauthorized))
+	require.EqualValues(t, 4, requestCount.Load())
+
+	// Provider errors should remain inspectable by callers
+	client.SetCredentialProvider(NewCredentialChain())
+
+	err = client.doRequest(context.Background(), http.MethodGet, "/foo/bar", params, nil)
+	require.ErrorIs(t, err, ErrNoCredentials)
+	require.EqualValues(t, 4, requestCount.Load())
+
+	// The refreshed attempt shouldn't count against the retry budget
+	client.SetRetryCount(1)
+	client.SetCredentialProvider(NewCredentialChain(&testCredentialProvider{tokens: []string{"first", "second"}}))
+
+	require.NoError(t, client.doRequest(context.Background(), http.MethodGet, "/foo/bar", params, nil))
+	require.EqualValues(t, 6, requestCount.Load())
+}
+
+func TestCredentials_ChainInvalidateDuringRetrieve(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	var calls atomic.Int32
+
+	chain := NewCredentialChain(credentialProviderFunc(func(context.Context) (*Credentials, error) {
+		if calls.Add(1) > 1 {
+			return &Credentials{Token: "fresh"}, nil
+		}
+
+		close(started)
+		<-release
+
+		return &Credentials{Token: "stale"}, nil
+	}))
+
+	result := make(chan *Credentials, 1)
+
+	go func() {
+		creds, _ := chain.Retrieve(context.Background())
+		result <- creds
+	}()
+
+	<-started
+
+	// Invalidate must not wait for the provider
+	chain.Invalidate()
+
+	// Callers after the invalidation must not share the stale resolution
+	creds, err := chain.Retrieve(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "fresh", creds.Token)
+
+	close(release)
+
+	// The stale credentials must not replace the fresh ones
+	require.Equal(t, "stale", (<-result).Token)
+	require.Equal(t, "fresh", chain.cached.Token)
+}
+
+type credentialProviderFunc func(ctx context.Context) (*Credentials, error)
+
+func (f credentialProviderFunc) Retrieve(ctx context.Context) (*Credentials, error) {
+	return f(ctx)
+}
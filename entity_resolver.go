@@ -0,0 +1,282 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// EntityReference is a reference to an API object which can be resolved using ResolveEntity(...).
+// It is implemented by EventEntity, TicketEntity and LinodeEntity.
+type EntityReference interface {
+	entityReference() (entityType EntityType, id string, url string)
+}
+
+func (e EventEntity) entityReference() (EntityType, string, string) {
+	return e.Type, formatEventEntityID(e.ID), e.URL
+}
+
+func (e TicketEntity) entityReference() (EntityType, string, string) {
+	return EntityType(e.Type), strconv.Itoa(e.ID), e.URL
+}
+
+func (e LinodeEntity) entityReference() (EntityType, string, string) {
+	return EntityType(e.Type), strconv.Itoa(e.ID), ""
+}
+
+// UnsupportedEntityError is returned when an entity reference cannot be resolved
+// because its type or URL is not supported.
+type UnsupportedEntityError struct {
+	Type EntityType
+	URL  string
+}
+
+func (e *UnsupportedEntityError) Error() string {
+	if e.URL != "" {
+		return fmt.Sprintf("unsupported entity type %q with URL %q", e.Type, e.URL)
+	}
+
+	return fmt.Sprintf("unsupported entity type %q", e.Type)
+}
+
+// ResolveEntity fetches the object referenced by the given entity reference,
+// e.g. an *Instance for a linode entity.
+//
+// Nested entities such as disks, configs, node pools and buckets are resolved using the
+// reference's URL when available; otherwise the entity's type and ID are used.
+// An *UnsupportedEntityError is returned if the reference cannot be resolved.
+func (c *Client) ResolveEntity(ctx context.Context, ref EntityReference) (any, error) {
+	entityType, id, entityURL := ref.entityReference()
+
+	if entityURL != "" {
+		if resolve, params, ok := matchEntityRoute(entityURL); ok {
+			return resolve(ctx, c, params)
+		}
+	}
+
+	if getter, ok := entityGetters[entityType]; ok && id != "" {
+		return getter(ctx, c, id)
+	}
+
+	return nil, &UnsupportedEntityError{Type: entityType, URL: entityURL}
+}
+
+// ResolveEntityAs fetches the object referenced by the given entity reference,
+// returning an error if it does not resolve to a *T.
+func ResolveEntityAs[T any](ctx context.Context, client *Client, ref EntityReference) (*T, error) {
+	value, err := client.ResolveEntity(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	result, ok := value.(*T)
+	if !ok {
+		return nil, fmt.Errorf("entity resolved to %T rather than %T", value, result)
+	}
+
+	return result, nil
+}
+
+// entityGetter fetches the object of an entity type by its ID.
+type entityGetter func(ctx context.Context, c *Client, id string) (any, error)
+
+// entityGetters maps supported entity types to the getter for their objects.
+var entityGetters = map[EntityType]entityGetter{
+	EntityLinode:         intEntityGetter((*Client).GetInstance),
+	EntityVolume:         intEntityGetter((*Client).GetVolume),
+	EntityDomain:         intEntityGetter((*Client).GetDomain),
+	EntityLKECluster:     intEntityGetter((*Client).GetLKECluster),
+	EntityNodebalancer:   intEntityGetter((*Client).GetNodeBalancer),
+	EntityFirewall:       intEntityGetter((*Client).GetFirewall),
+	EntityPlacementGroup: intEntityGetter((*Client).GetPlacementGroup),
+	EntityVPC:            intEntityGetter((*Client).GetVPC),
+	EntityStackscript:    intEntityGetter((*Client).GetStackscript),
+	EntityLongview:       intEntityGetter((*Client).GetLongviewClient),
+	EntityTicket:         intEntityGetter((*Client).GetTicket),
+	EntityUserSSHKey:     intEntityGetter((*Client).GetSSHKey),
+	EntityToken:          intEntityGetter((*Client).GetToken),
+	EntityImage:          stringEntityGetter((*Client).GetImage),
+	EntityUser:           stringEntityGetter((*Client).GetUser),
+	EntityOAuthClient:    stringEntityGetter((*Client).GetOAuthClient),
+	EntityIPAddress:      stringEntityGetter((*Client).GetIPAddress),
+}
+
+func intEntityGetter[T any](get func(*Client, context.Context, int) (*T, error)) entityGetter {
+	return func(ctx context.Context, c *Client, id string) (any, error) {
+		intID, err := strconv.Atoi(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid entity ID %q: %w", id, err)
+		}
+
+		return entityResult(get(c, ctx, intID))
+	}
+}
+
+func stringEntityGetter[T any](get func(*Client, context.Context, string) (*T, error)) entityGetter {
+	return func(ctx context.Context, c *Client, id string) (any, error) {
+		return entityResult(get(c, ctx, id))
+	}
+}
+
+// entityResult converts the result of a getter to an untyped result,
+// making sure a failed lookup does not return a typed nil pointer.
+func entityResult[T any](value *T, err error) (any, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// entityRouteResolver fetches the object of an entity URL using the parameters parsed from the URL.
+type entityRouteResolver func(ctx context.Context, c *Client, params []string) (any, error)
+
+// entityRoute maps an entity URL pattern to the getter for its objects.
+// Parameters are denoted by "{}", and a trailing "{...}" matches all remaining segments.
+type entityRoute struct {
+	pattern string
+	resolve entityRouteResolver
+}
+
+// entityRoutes lists the supported entity URL patterns relative to the API version.
+var entityRoutes = []entityRoute{
+	{"linode/instances/{}", intRoute((*Client).GetInstance)},
+	{"linode/instances/{}/disks/{}", intIntRoute((*Client).GetInstanceDisk)},
+	{"linode/instances/{}/configs/{}", intIntRoute((*Client).GetInstanceConfig)},
+	{"linode/instances/{}/backups/{}", intIntRoute((*Client).GetInstanceSnapshot)},
+	{"linode/stackscripts/{}", intRoute((*Client).GetStackscript)},
+	{"volumes/{}", intRoute((*Client).GetVolume)},
+	{"domains/{}", intRoute((*Client).GetDomain)},
+	{"domains/{}/records/{}", intIntRoute((*Client).GetDomainRecord)},
+	{"lke/clusters/{}", intRoute((*Client).GetLKECluster)},
+	{"lke/clusters/{}/pools/{}", intIntRoute((*Client).GetLKENodePool)},
+	{"lke/clusters/{}/nodes/{}", intStringRoute((*Client).GetLKENodePoolNode)},
+	{"nodebalancers/{}", intRoute((*Client).GetNodeBalancer)},
+	{"nodebalancers/{}/configs/{}", intIntRoute((*Client).GetNodeBalancerConfig)},
+	{"networking/firewalls/{}", intRoute((*Client).GetFirewall)},
+	{"networking/firewalls/{}/devices/{}", intIntRoute((*Client).GetFirewallDevice)},
+	{"networking/ips/{}", stringRoute((*Client).GetIPAddress)},
+	{"images/{...}", stringRoute((*Client).GetImage)},
+	{"object-storage/buckets/{}/{}", stringStringRoute((*Client).GetObjectStorageBucket)},
+	{"object-storage/keys/{}", intRoute((*Client).GetObjectStorageKey)},
+	{"databases/mysql/instances/{}", intRoute((*Client).GetMySQLDatabase)},
+	{"databases/postgresql/instances/{}", intRoute((*Client).GetPostgresDatabase)},
+	{"placement/groups/{}", intRoute((*Client).GetPlacementGroup)},
+	{"vpcs/{}", intRoute((*Client).GetVPC)},
+	{"vpcs/{}/subnets/{}", intIntRoute((*Client).GetVPCSubnet)},
+	{"longview/clients/{}", intRoute((*Client).GetLongviewClient)},
+	{"support/tickets/{}", intRoute((*Client).GetTicket)},
+	{"profile/sshkeys/{}", intRoute((*Client).GetSSHKey)},
+	{"profile/tokens/{}", intRoute((*Client).GetToken)},
+	{"account/users/{}", stringRoute((*Client).GetUser)},
+	{"account/oauth-clients/{}", stringRoute((*Client).GetOAuthClient)},
+}
+
+// matchEntityRoute finds the route matching the given entity URL, returning its resolver and parameters.
+func matchEntityRoute(entityURL string) (entityRouteResolver, []string, bool) {
+	parsed, err := url.Parse(entityURL)
+	if err != nil {
+		return nil, nil, false
+	}
+
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+
+	// Strip the API version, e.g. "v4" or "v4beta"
+	if len(segments) > 0 && strings.HasPrefix(segments[0], "v4") {
+		segments = segments[1:]
+	}
+
+	for _, route := range entityRoutes {
+		if params, ok := matchEntityPattern(strings.Split(route.pattern, "/"), segments); ok {
+			return route.resolve, params, true
+		}
+	}
+
+	return nil, nil, false
+}
+
+func matchEntityPattern(pattern, segments []string) ([]string, bool) {
+	params := make([]string, 0)
+
+	for i, part := range pattern {
+		if part == "{...}" && i < len(segments) {
+			return append(params, strings.Join(segments[i:], "/")), true
+		}
+
+		if i >= len(segments) {
+			return nil, false
+		}
+
+		segment, err := url.PathUnescape(segments[i])
+		if err != nil {
+			return nil, false
+		}
+
+		switch part {
+		case "{}":
+			params = append(params, segment)
+		case segment:
+		default:
+			return nil, false
+		}
+	}
+
+	return params, len(pattern) == len(segments)
+}
+
+func intRoute[T any](get func(*Client, context.Context, int) (*T, error)) entityRouteResolver {
+	return func(ctx context.Context, c *Client, params []string) (any, error) {
+		return intEntityGetter(get)(ctx, c, params[0])
+	}
+}
+
+func stringRoute[T any](get func(*Client, context.Context, string) (*T, error)) entityRouteResolver {
+	return func(ctx context.Context, c *Client, params []string) (any, error) {
+		return entityResult(get(c, ctx, params[0]))
+	}
+}
+
+func intIntRoute[T any](get func(*Client, context.Context, int, int) (*T, error)) entityRouteResolver {
+	return func(ctx context.Context, c *Client, params []string) (any, error) {
+		ids, err := parseEntityIDs(params)
+		if err != nil {
+			return nil, err
+		}
+
+		return entityResult(get(c, ctx, ids[0], ids[1]))
+	}
+}
+
+func intStringRoute[T any](get func(*Client, context.Context, int, string) (*T, error)) entityRouteResolver {
+	return func(ctx context.Context, c *Client, params []string) (any, error) {
+		ids, err := parseEntityIDs(params[:1])
+		if err != nil {
+			return nil, err
+		}
+
+		return entityResult(get(c, ctx, ids[0], params[1]))
+	}
+}
+
+func stringStringRoute[T any](get func(*Client, context.Context, string, string) (*T, error)) entityRouteResolver {
+	return func(ctx context.Context, c *Client, params []string) (any, error) {
+		return entityResult(get(c, ctx, params[0], params[1]))
+	}
+}
+
+func parseEntityIDs(params []string) ([]int, error) {
+	result := make([]int, len(params))
+
+	for i, param := range params {
+		id, err := strconv.Atoi(param)
+		if err != nil {
+			return nil, fmt.Errorf("invalid entity ID %q: %w", param, err)
+		}
+
+		result[i] = id
+	}
+
+	return result, nil
+}
@@ -0,0 +1,382 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultEventDispatcherConcurrency is the default maximum number of
+	// handlers run concurrently by an EventDispatcher.
+	DefaultEventDispatcherConcurrency = 4
+
+	// DefaultEventDispatcherCacheTTL is the default duration resolved entities
+	// are cached for by an EventDispatcher.
+	DefaultEventDispatcherCacheTTL = 30 * time.Second
+)
+
+// EventMatch selects the events an event handler is called for.
+// Empty fields match any value.
+type EventMatch struct {
+	EntityTypes []EntityType
+	Actions     []EventAction
+	Statuses    []EventStatus
+}
+
+func (m EventMatch) matches(event Event) bool {
+	if len(m.Actions) > 0 && !slices.Contains(m.Actions, event.Action) {
+		return false
+	}
+
+	if len(m.Statuses) > 0 && !slices.Contains(m.Statuses, event.Status) {
+		return false
+	}
+
+	if len(m.EntityTypes) > 0 {
+		return event.Entity != nil && slices.Contains(m.EntityTypes, event.Entity.Type)
+	}
+
+	return true
+}
+
+// ResolvedEvent is an event update passed to event handlers, alongside the
+// objects referenced by the event's entities.
+type ResolvedEvent struct {
+	EventUpdate
+
+	// Entity is the object referenced by the event's primary entity, e.g. an *Instance,
+	// or nil if the entity could not be resolved.
+	Entity any
+
+	// SecondaryEntity is the object referenced by the event's secondary entity,
+	// or nil if the event has no secondary entity or it could not be resolved.
+	SecondaryEntity any
+
+	// ResolveErr describes why any of the event's entities could not be resolved,
+	// e.g. because the entity has since been deleted.
+	ResolveErr error
+
+	// entityErr describes why the event's primary entity could not be resolved
+	entityErr error
+}
+
+// EventHandlerFunc handles an event dispatched by an EventDispatcher.
+type EventHandlerFunc func(ctx context.Context, event ResolvedEvent) error
+
+// EventDispatcherOptions configures an EventDispatcher.
+type EventDispatcherOptions struct {
+	// Concurrency is the maximum number of handlers run concurrently.
+	// Defaults to DefaultEventDispatcherConcurrency.
+	Concurrency int
+
+	// MaxRetries is the number of times a failed handler is retried.
+	MaxRetries int
+
+	// RetryDelay is the duration to wait before retrying a failed handler.
+	// Defaults to the client's poll delay.
+	RetryDelay time.Duration
+
+	// CacheTTL is the duration resolved entities are cached for.
+	// Defaults to DefaultEventDispatcherCacheTTL.
+	CacheTTL time.Duration
+
+	// DisableResolution disables resolving the entities of dispatched events.
+	DisableResolution bool
+
+	// OnError is called when a handler fails after all retries.
+	// By default, handler failures are logged.
+	OnError func(event ResolvedEvent, err error)
+}
+
+// EventDispatcher routes events to the handlers registered for them.
+//
+// Handlers receive the event alongside the objects referenced by its entities, which are
+// fetched using the corresponding getter and cached. Handler failures are isolated from
+// each other and retried according to the dispatcher's options.
+type EventDispatcher struct {
+	client Client
+	opts   EventDispatcherOptions
+
+	lock     sync.RWMutex
+	handlers []eventHandlerRegistration
+
+	semaphore chan struct{}
+
+	cacheLock   sync.Mutex
+	cache       map[string]dispatcherCacheEntry
+	cachePruned time.Time
+	inflight    inflightGroup
+}
+
+type eventHandlerRegistration struct {
+	match   EventMatch
+	handler EventHandlerFunc
+}
+
+type dispatcherCacheEntry struct {
+	value   any
+	expires time.Time
+}
+
+// NewEventDispatcher creates a new EventDispatcher.
+func (c *Client) NewEventDispatcher(opts *EventDispatcherOptions) *EventDispatcher {
+	result := &EventDispatcher{
+		client: *c,
+		cache:  make(map[string]dispatcherCacheEntry),
+	}
+
+	if opts != nil {
+		result.opts = *opts
+	}
+
+	if result.opts.Concurrency <= 0 {
+		result.opts.Concurrency = DefaultEventDispatcherConcurrency
+	}
+
+	if result.opts.RetryDelay <= 0 {
+		result.opts.RetryDelay = c.GetPollDelay()
+	}
+
+	if result.opts.CacheTTL <= 0 {
+		result.opts.CacheTTL = DefaultEventDispatcherCacheTTL
+	}
+
+	result.semaphore = make(chan struct{}, result.opts.Concurrency)
+
+	return result
+}
+
+// Handle registers a handler for the events selected by the given match.
+func (d *EventDispatcher) Handle(match EventMatch, handler EventHandlerFunc) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.handlers = append(d.handlers, eventHandlerRegistration{match: match, handler: handler})
+}
+
+// HandleEntity registers a handler for the events selected by the given match
+// whose primary entity resolves to a *T, e.g. an *Instance.
+// Events whose primary entity no longer exists, e.g. *_delete events, are skipped.
+//
+// For example, to attach a firewall to each newly created instance:
+//
+//	linodego.HandleEntity(dispatcher, linodego.EventMatch{
+//		Actions:  []linodego.EventAction{linodego.ActionLinodeCreate},
+//		Statuses: []linodego.EventStatus{linodego.EventFinished},
+//	}, func(ctx context.Context, event linodego.ResolvedEvent, instance *linodego.Instance) error {
+//		_, err := client.CreateFirewallDevice(ctx, firewallID, linodego.FirewallDeviceCreateOptions{
+//			ID:   instance.ID,
+//			Type: linodego.FirewallDeviceLinode,
+//		})
+//		return err
+//	})
+func HandleEntity[T any](
+	d *EventDispatcher,
+	match EventMatch,
+	handler func(ctx context.Context, event ResolvedEvent, entity *T) error,
+) {
+	d.Handle(match, func(ctx context.Context, event ResolvedEvent) error {
+		entity, ok := event.Entity.(*T)
+		if !ok {
+			// Report entities which failed to resolve rather than silently dropping their events,
+			// unless the entity has been deleted
+			if event.Entity == nil && event.entityErr != nil && !IsNotFound(event.entityErr) {
+				return fmt.Errorf("failed to resolve event entity: %w", event.entityErr)
+			}
+
+			return nil
+		}
+
+		return handler(ctx, event, entity)
+	})
+}
+
+// Run dispatches the updates delivered by the given subscriber until ctx is canceled.
+func (d *EventDispatcher) Run(ctx context.Context, subscriber *EventSubscriber) error {
+	return subscriber.Run(ctx, d.Dispatch)
+}
+
+// Dispatch calls all handlers registered for the given event update and waits for them to complete.
+// Handler failures are reported using the OnError option rather than returned, so an error
+// is only returned if ctx is canceled.
+func (d *EventDispatcher) Dispatch(ctx context.Context, update EventUpdate) error {
+	d.lock.RLock()
+
+	handlers := make([]EventHandlerFunc, 0)
+
+	for _, registration := range d.handlers {
+		if registration.match.matches(update.Event) {
+			handlers = append(handlers, registration.handler)
+		}
+	}
+
+	d.lock.RUnlock()
+
+	if len(handlers) == 0 {
+		return nil
+	}
+
+	event := d.resolve(ctx, update)
+
+	var wg sync.WaitGroup
+
+	for _, handler := range handlers {
+		select {
+		case d.semaphore <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return ctx.Err()
+		}
+
+		// Each handler receives its own copy of the resolved entities, so handlers
+		// can't modify the cached entities or those received by other handlers
+		handlerEvent := event
+		handlerEvent.Entity = deepCopy(event.Entity)
+		handlerEvent.SecondaryEntity = deepCopy(event.SecondaryEntity)
+
+		wg.Go(func() {
+			defer func() { <-d.semaphore }()
+
+			if err := d.runHandler(ctx, handler, handlerEvent); err != nil {
+				d.reportError(handlerEvent, err)
+			}
+		})
+	}
+
+	wg.Wait()
+
+	return ctx.Err()
+}
+
+// runHandler calls the given handler, retrying it on failure.
+func (d *EventDispatcher) runHandler(ctx context.Context, handler EventHandlerFunc, event ResolvedEvent) (err error) {
+	defer func() {
+		// Isolate panicking handlers from the dispatcher and other handlers
+		if r := recover(); r != nil {
+			err = fmt.Errorf("event handler panicked: %v", r)
+		}
+	}()
+
+	for attempt := 0; ; attempt++ {
+		err = handler(ctx, event)
+		if err == nil || attempt >= d.opts.MaxRetries {
+			return err
+		}
+
+		select {
+		case <-time.After(d.opts.RetryDelay):
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		}
+	}
+}
+
+func (d *EventDispatcher) reportError(event ResolvedEvent, err error) {
+	if d.opts.OnError != nil {
+		d.opts.OnError(event, err)
+		return
+	}
+
+	log.Printf("[WARN] Event handler failed for event %d (%s): %s", event.Event.ID, event.Event.Action, err)
+}
+
+// resolve resolves the entities of the given event update.
+func (d *EventDispatcher) resolve(ctx context.Context, update EventUpdate) ResolvedEvent {
+	result := ResolvedEvent{EventUpdate: update}
+
+	if d.opts.DisableResolution {
+		return result
+	}
+
+	var errs []error
+
+	if update.Event.Entity != nil {
+		// Completed events may have changed the entity, so any cached value is stale
+		if update.Event.Status == EventFinished || update.Event.Status == EventFailed {
+			d.invalidate(update.Event.Entity)
+		}
+
+		entity, err := d.resolveEntity(ctx, update.Event.Entity)
+		if err != nil {
+			result.entityErr = err
+			errs = append(errs, fmt.Errorf("failed to resolve entity: %w", err))
+		}
+
+		result.Entity = entity
+	}
+
+	if update.Event.SecondaryEntity != nil {
+		entity, err := d.resolveEntity(ctx, update.Event.SecondaryEntity)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to resolve secondary entity: %w", err))
+		}
+
+		result.SecondaryEntity = entity
+	}
+
+	result.ResolveErr = errors.Join(errs...)
+
+	return result
+}
+
+// resolveEntity resolves the given entity, using a cached value if available.
+func (d *EventDispatcher) resolveEntity(ctx context.Context, entity *EventEntity) (any, error) {
+	key := dispatcherCacheKey(entity)
+
+	d.cacheLock.Lock()
+	entry, ok := d.cache[key]
+	d.cacheLock.Unlock()
+
+	if ok && time.Now().Before(entry.expires) {
+		return entry.value, nil
+	}
+
+	// Coalesce concurrent resolutions of the same entity
+	return d.inflight.do(ctx, key, func(ctx context.Context) (any, error) {
+		value, err := d.client.ResolveEntity(ctx, entity)
+		if err != nil {
+			return nil, err
+		}
+
+		d.cacheLock.Lock()
+		d.pruneCache()
+		d.cache[key] = dispatcherCacheEntry{value: value, expires: time.Now().Add(d.opts.CacheTTL)}
+		d.cacheLock.Unlock()
+
+		return value, nil
+	})
+}
+
+// pruneCache removes expired entries from the cache at most once per CacheTTL,
+// so that the cache of a long-running dispatcher doesn't grow without bound.
+// The cache lock must be held by the caller.
+func (d *EventDispatcher) pruneCache() {
+	now := time.Now()
+	if now.Sub(d.cachePruned) < d.opts.CacheTTL {
+		return
+	}
+
+	d.cachePruned = now
+
+	for key, entry := range d.cache {
+		if !now.Before(entry.expires) {
+			delete(d.cache, key)
+		}
+	}
+}
+
+func (d *EventDispatcher) invalidate(entity *EventEntity) {
+	d.cacheLock.Lock()
+	defer d.cacheLock.Unlock()
+
+	delete(d.cache, dispatcherCacheKey(entity))
+}
+
+func dispatcherCacheKey(entity *EventEntity) string {
+	return fmt.Sprintf("%s:%s", entity.Type, formatEventEntityID(entity.ID))
+}
@@ -0,0 +1,31 @@
+package linodego
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventDispatcher_PrunesExpiredCacheEntries(t *testing.T) {
+	client := newTestClient(t, nil)
+	dispatcher := client.NewEventDispatcher(&EventDispatcherOptions{CacheTTL: time.Minute})
+
+	dispatcher.cache["linode:1"] = dispatcherCacheEntry{expires: time.Now().Add(-time.Second)}
+	dispatcher.cache["linode:2"] = dispatcherCacheEntry{expires: time.Now().Add(time.Minute)}
+
+	dispatcher.pruneCache()
+	require.Len(t, dispatcher.cache, 1)
+	require.Contains(t, dispatcher.cache, "linode:2")
+
+	// Entries expiring after a prune are removed by the next prune once CacheTTL has passed
+	dispatcher.cache["linode:3"] = dispatcherCacheEntry{expires: time.Now().Add(-time.Second)}
+
+	dispatcher.pruneCache()
+	require.Contains(t, dispatcher.cache, "linode:3")
+
+	dispatcher.cachePruned = time.Now().Add(-time.Minute)
+
+	dispatcher.pruneCache()
+	require.NotContains(t, dispatcher.cache, "linode:3")
+}
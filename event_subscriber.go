@@ -0,0 +1,366 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"sync"
+	"time"
+)
+
+// EventCheckpoint records the position of an EventSubscriber in the account's event stream.
+type EventCheckpoint struct {
+	// LastEventID is the ID of the most recent event processed by the subscriber.
+	LastEventID int `json:"last_event_id"`
+
+	// Pending maps the IDs of events that were in progress when last delivered
+	// to their last delivered status, so their status transitions can be delivered.
+	Pending map[int]EventStatus `json:"pending,omitempty"`
+}
+
+// EventCheckpointStore persists the checkpoint of an EventSubscriber so that
+// it can resume from where it left off, e.g. after a restart.
+type EventCheckpointStore interface {
+	// LoadCheckpoint returns the stored checkpoint, or nil if no checkpoint has been stored.
+	LoadCheckpoint(ctx context.Context) (*EventCheckpoint, error)
+
+	// SaveCheckpoint stores the given checkpoint.
+	SaveCheckpoint(ctx context.Context, checkpoint *EventCheckpoint) error
+}
+
+// MemoryEventCheckpointStore is an EventCheckpointStore which stores the checkpoint in memory.
+type MemoryEventCheckpointStore struct {
+	lock       sync.Mutex
+	checkpoint *EventCheckpoint
+}
+
+// LoadCheckpoint implements EventCheckpointStore.
+func (s *MemoryEventCheckpointStore) LoadCheckpoint(_ context.Context) (*EventCheckpoint, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.checkpoint == nil {
+		return nil, nil
+	}
+
+	return s.checkpoint.clone(), nil
+}
+
+// SaveCheckpoint implements EventCheckpointStore.
+func (s *MemoryEventCheckpointStore) SaveCheckpoint(_ context.Context, checkpoint *EventCheckpoint) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.checkpoint = checkpoint.clone()
+
+	return nil
+}
+
+// FileEventCheckpointStore is an EventCheckpointStore which stores the checkpoint
+// as JSON in the file at the given path.
+type FileEventCheckpointStore struct {
+	Path string
+}
+
+// LoadCheckpoint implements EventCheckpointStore.
+func (s FileEventCheckpointStore) LoadCheckpoint(_ context.Context) (*EventCheckpoint, error) {
+	contents, err := os.ReadFile(s.Path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to read event checkpoint %s: %w", s.Path, err)
+	}
+
+	var result EventCheckpoint
+	if err := json.Unmarshal(contents, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse event checkpoint %s: %w", s.Path, err)
+	}
+
+	return &result, nil
+}
+
+// SaveCheckpoint implements EventCheckpointStore.
+func (s FileEventCheckpointStore) SaveCheckpoint(_ context.Context, checkpoint *EventCheckpoint) error {
+	err := writeFileAtomic(s.Path, func(w io.Writer) error {
+		return json.NewEncoder(w).Encode(checkpoint)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save event checkpoint %s: %w", s.Path, err)
+	}
+
+	return nil
+}
+
+func (c *EventCheckpoint) clone() *EventCheckpoint {
+	return &EventCheckpoint{
+		LastEventID: c.LastEventID,
+		Pending:     maps.Clone(c.Pending),
+	}
+}
+
+// EventUpdate is a new event or a status transition of a previously delivered event.
+type EventUpdate struct {
+	Event Event
+
+	// PreviousStatus is the status the event had when it was last delivered,
+	// or empty if the event is new.
+	PreviousStatus EventStatus
+}
+
+// IsNew returns whether this is the first delivery of the event.
+func (u EventUpdate) IsNew() bool {
+	return u.PreviousStatus == ""
+}
+
+// EventSubscriberOptions configures an EventSubscriber.
+type EventSubscriberOptions struct {
+	// EntityTypes restricts the delivered events to the given entity types.
+	// If empty, events for all entity types are delivered.
+	EntityTypes []EntityType
+
+	// Actions restricts the delivered events to the given actions.
+	// If empty, events for all actions are delivered.
+	Actions []EventAction
+
+	// Interval is the duration between polls. Defaults to the client's poll delay.
+	Interval time.Duration
+
+	// MarkSeen marks delivered events as seen using MarkEventsSeen(...).
+	MarkSeen bool
+
+	// Store persists the subscriber's checkpoint. Defaults to a MemoryEventCheckpointStore.
+	// If the store has no checkpoint, the subscriber starts after the most recent existing event.
+	Store EventCheckpointStore
+}
+
+// EventSubscriber tails the account's event stream, delivering new events and
+// status transitions of in-progress events (e.g. started to finished).
+//
+// Events are delivered at least once: the checkpoint is only advanced once all updates
+// from a poll have been handled, so updates may be redelivered if handling fails.
+type EventSubscriber struct {
+	client Client
+	opts   EventSubscriberOptions
+
+	checkpoint *EventCheckpoint
+}
+
+// NewEventSubscriber creates a new EventSubscriber for the account's event stream.
+func (c *Client) NewEventSubscriber(opts *EventSubscriberOptions) *EventSubscriber {
+	result := &EventSubscriber{client: *c}
+
+	if opts != nil {
+		result.opts = *opts
+	}
+
+	if result.opts.Interval <= 0 {
+		result.opts.Interval = c.GetPollDelay()
+	}
+
+	if result.opts.Store == nil {
+		result.opts.Store = &MemoryEventCheckpointStore{}
+	}
+
+	return result
+}
+
+// Run polls for event updates until ctx is canceled or handler returns an error,
+// calling handler with each update in order of event ID.
+func (s *EventSubscriber) Run(ctx context.Context, handler func(ctx context.Context, update EventUpdate) error) error {
+	ticker := time.NewTicker(s.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Poll(ctx, handler); err != nil {
+			return err
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Poll checks for event updates once, calling handler with each update in order of event ID.
+// The subscriber's checkpoint is saved once all updates have been handled.
+func (s *EventSubscriber) Poll(ctx context.Context, handler func(ctx context.Context, update EventUpdate) error) error {
+	if s.checkpoint == nil {
+		if err := s.loadCheckpoint(ctx); err != nil {
+			return err
+		}
+	}
+
+	checkpoint := s.checkpoint.clone()
+	if checkpoint.Pending == nil {
+		checkpoint.Pending = make(map[int]EventStatus)
+	}
+
+	updates, err := s.pendingUpdates(ctx, checkpoint)
+	if err != nil {
+		return err
+	}
+
+	newEvents, err := s.listEventsAfter(ctx, checkpoint.LastEventID)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range newEvents {
+		checkpoint.LastEventID = max(checkpoint.LastEventID, event.ID)
+
+		if !s.matches(event) {
+			continue
+		}
+
+		updates = append(updates, EventUpdate{Event: event})
+
+		if eventInProgress(event.Status) {
+			checkpoint.Pending[event.ID] = event.Status
+		}
+	}
+
+	slices.SortStableFunc(updates, func(a, b EventUpdate) int {
+		return a.Event.ID - b.Event.ID
+	})
+
+	for _, update := range updates {
+		if err := handler(ctx, update); err != nil {
+			return err
+		}
+	}
+
+	if s.opts.MarkSeen && len(newEvents) > 0 {
+		if err := s.client.MarkEventsSeen(ctx, &newEvents[len(newEvents)-1]); err != nil {
+			return fmt.Errorf("failed to mark events seen: %w", err)
+		}
+	}
+
+	if err := s.opts.Store.SaveCheckpoint(ctx, checkpoint); err != nil {
+		return err
+	}
+
+	s.checkpoint = checkpoint
+
+	return nil
+}
+
+// loadCheckpoint loads the subscriber's checkpoint from its store, starting after
+// the most recent existing event if no checkpoint has been stored.
+func (s *EventSubscriber) loadCheckpoint(ctx context.Context) error {
+	checkpoint, err := s.opts.Store.LoadCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	if checkpoint != nil {
+		s.checkpoint = checkpoint
+		return nil
+	}
+
+	filter, err := (&Filter{OrderBy: "created", Order: Descending}).MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	events, err := s.client.ListEvents(ctx, &ListOptions{
+		Filter:      string(filter),
+		PageOptions: &PageOptions{Page: 1},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list events: %w", err)
+	}
+
+	s.checkpoint = &EventCheckpoint{}
+
+	for _, event := range events {
+		s.checkpoint.LastEventID = max(s.checkpoint.LastEventID, event.ID)
+	}
+
+	return nil
+}
+
+// pendingUpdates polls the in-progress events of the given checkpoint,
+// returning an update for each event with a changed status.
+func (s *EventSubscriber) pendingUpdates(ctx context.Context, checkpoint *EventCheckpoint) ([]EventUpdate, error) {
+	if len(checkpoint.Pending) == 0 {
+		return nil, nil
+	}
+
+	events, err := listByIDsChunked(ctx, s.client.ListEvents, func(e Event) int { return e.ID },
+		slices.Sorted(maps.Keys(checkpoint.Pending)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending events: %w", err)
+	}
+
+	result := make([]EventUpdate, 0)
+
+	for id, previousStatus := range checkpoint.Pending {
+		event, ok := events[id]
+		if !ok {
+			// The event is no longer available, so no further transitions can be observed
+			delete(checkpoint.Pending, id)
+			continue
+		}
+
+		if event.Status == previousStatus {
+			continue
+		}
+
+		result = append(result, EventUpdate{Event: event, PreviousStatus: previousStatus})
+
+		if eventInProgress(event.Status) {
+			checkpoint.Pending[id] = event.Status
+		} else {
+			delete(checkpoint.Pending, id)
+		}
+	}
+
+	return result, nil
+}
+
+// listEventsAfter lists all events with an ID greater than the given ID in ascending order.
+func (s *EventSubscriber) listEventsAfter(ctx context.Context, eventID int) ([]Event, error) {
+	filter := Filter{OrderBy: "created", Order: Ascending}
+	filter.AddField(Gt, "id", eventID)
+
+	filterStr, err := filter.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.client.ListEvents(ctx, &ListOptions{Filter: string(filterStr)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	slices.SortFunc(events, func(a, b Event) int {
+		return a.ID - b.ID
+	})
+
+	return events, nil
+}
+
+func (s *EventSubscriber) matches(event Event) bool {
+	if len(s.opts.Actions) > 0 && !slices.Contains(s.opts.Actions, event.Action) {
+		return false
+	}
+
+	if len(s.opts.EntityTypes) > 0 {
+		return event.Entity != nil && slices.Contains(s.opts.EntityTypes, event.Entity.Type)
+	}
+
+	return true
+}
+
+func eventInProgress(status EventStatus) bool {
+	return status == EventScheduled || status == EventStarted
+}
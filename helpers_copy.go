@@ -0,0 +1,80 @@
+package linodego
+
+import "reflect"
+
+// deepCopy returns a deep copy of the given value, so that the copy's pointers,
+// slices and maps can be modified without affecting the original.
+// Unexported struct fields are copied shallowly.
+func deepCopy[T any](value T) T {
+	var result T
+	reflect.ValueOf(&result).Elem().Set(deepCopyValue(reflect.ValueOf(&value).Elem()))
+
+	return result
+}
+
+func deepCopyValue(value reflect.Value) reflect.Value {
+	switch value.Kind() {
+	case reflect.Pointer:
+		if value.IsNil() {
+			return value
+		}
+
+		result := reflect.New(value.Type().Elem())
+		result.Elem().Set(deepCopyValue(value.Elem()))
+
+		return result
+	case reflect.Interface:
+		if value.IsNil() {
+			return value
+		}
+
+		result := reflect.New(value.Type()).Elem()
+		result.Set(deepCopyValue(value.Elem()))
+
+		return result
+	case reflect.Slice:
+		if value.IsNil() {
+			return value
+		}
+
+		result := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		for i := range value.Len() {
+			result.Index(i).Set(deepCopyValue(value.Index(i)))
+		}
+
+		return result
+	case reflect.Array:
+		result := reflect.New(value.Type()).Elem()
+		for i := range value.Len() {
+			result.Index(i).Set(deepCopyValue(value.Index(i)))
+		}
+
+		return result
+	case reflect.Map:
+		if value.IsNil() {
+			return value
+		}
+
+		result := reflect.MakeMapWithSize(value.Type(), value.Len())
+
+		iter := value.MapRange()
+		for iter.Next() {
+			result.SetMapIndex(iter.Key(), deepCopyValue(iter.Value()))
+		}
+
+		return result
+	case reflect.Struct:
+		result := reflect.New(value.Type()).Elem()
+		result.Set(value)
+
+		for i := range value.NumField() {
+			if value.Type().Field(i).IsExported() {
+				result.Field(i).Set(deepCopyValue(value.Field(i)))
+			}
+		}
+
+		return result
+	default:
+		return value
+	}
+}
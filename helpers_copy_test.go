@@ -0,0 +1,47 @@
+package linodego
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeepCopy(t *testing.T) {
+	created := time.Now()
+
+	original := &Instance{
+		ID:      123,
+		Created: &created,
+		Tags:    []string{"foo"},
+		IPv4:    []net.IP{net.ParseIP("192.0.2.1")},
+		Specs:   &InstanceSpec{Disk: 10},
+		Alerts:  &InstanceAlert{CPU: 90},
+	}
+
+	copied := deepCopy(original)
+	require.Equal(t, original, copied)
+
+	copied.Tags[0] = "bar"
+	copied.IPv4[0][15] = 2
+	copied.Specs.Disk = 20
+	copied.Alerts.CPU = 50
+	*copied.Created = created.Add(time.Hour)
+
+	require.Equal(t, []string{"foo"}, original.Tags)
+	require.Equal(t, "192.0.2.1", original.IPv4[0].String())
+	require.Equal(t, 10, original.Specs.Disk)
+	require.Equal(t, 90, original.Alerts.CPU)
+	require.Equal(t, created, *original.Created)
+
+	// Values held in interfaces are copied as well
+	var entity any = original
+
+	copiedEntity, ok := deepCopy(entity).(*Instance)
+	require.True(t, ok)
+	require.NotSame(t, original, copiedEntity)
+	require.Equal(t, original, copiedEntity)
+
+	require.Nil(t, deepCopy[any](nil))
+}
@@ -0,0 +1,395 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// DefaultIdleResourceAge is the default minimum age of unused images and powered off
+// instances reported by FindIdleResources(...).
+const DefaultIdleResourceAge = 30 * 24 * time.Hour
+
+// IdleResourceKind is the reason a resource was reported by FindIdleResources(...).
+type IdleResourceKind string
+
+// IdleResourceKind enums represent the reasons a resource was reported by FindIdleResources(...).
+const (
+	IdleUnattachedVolume         IdleResourceKind = "unattached_volume"
+	IdleNodeBalancerWithoutNodes IdleResourceKind = "nodebalancer_without_nodes"
+	IdleUnassignedReservedIP     IdleResourceKind = "unassigned_reserved_ip"
+	IdleFirewallWithoutDevices   IdleResourceKind = "firewall_without_devices"
+	IdleUnusedImage              IdleResourceKind = "unused_image"
+	IdleObjectStorageKeyNoAccess IdleResourceKind = "object_storage_key_without_access"
+	IdlePoweredOffInstance       IdleResourceKind = "powered_off_instance"
+)
+
+// IdleResourceOptions configures FindIdleResources(...).
+type IdleResourceOptions struct {
+	// UnusedImageAge is the minimum age of private images not used by any instance to be reported.
+	// Defaults to DefaultIdleResourceAge.
+	UnusedImageAge time.Duration
+
+	// PoweredOffAge is the minimum duration instances must have been powered off to be reported.
+	// Defaults to DefaultIdleResourceAge.
+	PoweredOffAge time.Duration
+}
+
+// IdleResourceFinding is a resource which appears to be orphaned or idle.
+type IdleResourceFinding struct {
+	Kind       IdleResourceKind `json:"kind"`
+	EntityType EntityType       `json:"entity_type"`
+	ID         string           `json:"id"`
+	Label      string           `json:"label"`
+	Region     string           `json:"region,omitzero"`
+
+	// Reason is a human-readable description of why the resource was reported.
+	Reason string `json:"reason"`
+
+	// EstimatedMonthlyCost is the resource's estimated monthly cost in USD based on the API's
+	// type pricing. It is zero for resources which aren't billed, e.g. firewalls, or whose
+	// pricing isn't available from the API, e.g. images and instances of retired types.
+	EstimatedMonthlyCost float64 `json:"estimated_monthly_cost"`
+}
+
+// IdleResourceReport contains the findings of FindIdleResources(...).
+type IdleResourceReport struct {
+	Findings []IdleResourceFinding `json:"findings"`
+}
+
+// EstimatedMonthlyCost returns the total estimated monthly cost of the findings in USD.
+func (r *IdleResourceReport) EstimatedMonthlyCost() float64 {
+	result := 0.0
+
+	for _, finding := range r.Findings {
+		result += finding.EstimatedMonthlyCost
+	}
+
+	return result
+}
+
+// FindIdleResources reports the account's resources which appear to be forgotten:
+//   - volumes not attached to an instance
+//   - NodeBalancers without configs, or without nodes which are up
+//   - reserved IP addresses not assigned to anything
+//   - firewalls without devices
+//   - private images not used by any instance and older than opts.UnusedImageAge
+//   - limited Object Storage keys without access to any bucket
+//   - instances powered off for longer than opts.PoweredOffAge
+//
+// Instances are considered powered off since their latest shutdown event. Instances without
+// a shutdown event are considered powered off for longer than the account's event history,
+// unless they were created more recently than opts.PoweredOffAge.
+func (c *Client) FindIdleResources(ctx context.Context, opts *IdleResourceOptions) (*IdleResourceReport, error) {
+	finder := &idleResourceFinder{
+		client: c,
+		prices: newPriceCatalog(c),
+		now:    time.Now(),
+		report: &IdleResourceReport{Findings: make([]IdleResourceFinding, 0)},
+	}
+
+	if opts != nil {
+		finder.opts = *opts
+	}
+
+	if finder.opts.UnusedImageAge <= 0 {
+		finder.opts.UnusedImageAge = DefaultIdleResourceAge
+	}
+
+	if finder.opts.PoweredOffAge <= 0 {
+		finder.opts.PoweredOffAge = DefaultIdleResourceAge
+	}
+
+	for _, find := range []func(ctx context.Context) error{
+		finder.findVolumes,
+		finder.findNodeBalancers,
+		finder.findReservedIPs,
+		finder.findFirewalls,
+		finder.findInstancesAndImages,
+		finder.findObjectStorageKeys,
+	} {
+		if err := find(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return finder.report, nil
+}
+
+type idleResourceFinder struct {
+	client *Client
+	prices *priceCatalog
+	opts   IdleResourceOptions
+	now    time.Time
+	report *IdleResourceReport
+}
+
+func (f *idleResourceFinder) add(finding IdleResourceFinding) {
+	f.report.Findings = append(f.report.Findings, finding)
+}
+
+func (f *idleResourceFinder) findVolumes(ctx context.Context) error {
+	volumes, err := f.client.ListVolumes(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list volumes: %w", err)
+	}
+
+	for _, volume := range volumes {
+		if volume.LinodeID != nil {
+			continue
+		}
+
+		cost, err := f.prices.volume(ctx, volume.Region, volume.Size)
+		if err != nil {
+			return err
+		}
+
+		f.add(IdleResourceFinding{
+			Kind:                 IdleUnattachedVolume,
+			EntityType:           EntityVolume,
+			ID:                   strconv.Itoa(volume.ID),
+			Label:                volume.Label,
+			Region:               volume.Region,
+			Reason:               "volume is not attached to an instance",
+			EstimatedMonthlyCost: cost.Monthly,
+		})
+	}
+
+	return nil
+}
+
+func (f *idleResourceFinder) findNodeBalancers(ctx context.Context) error {
+	nodebalancers, err := f.client.ListNodeBalancers(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list NodeBalancers: %w", err)
+	}
+
+	for _, nodebalancer := range nodebalancers {
+		configs, err := f.client.ListNodeBalancerConfigs(ctx, nodebalancer.ID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list configs of NodeBalancer %d: %w", nodebalancer.ID, err)
+		}
+
+		reason := "NodeBalancer has no configs"
+
+		if len(configs) > 0 {
+			up := 0
+
+			for _, config := range configs {
+				if config.NodesStatus != nil {
+					up += config.NodesStatus.Up
+				}
+			}
+
+			if up > 0 {
+				continue
+			}
+
+			reason = "NodeBalancer has no nodes which are up"
+		}
+
+		cost, err := f.prices.nodeBalancer(ctx, nodebalancer.Type, nodebalancer.Region)
+		if err != nil {
+			return err
+		}
+
+		label := ""
+		if nodebalancer.Label != nil {
+			label = *nodebalancer.Label
+		}
+
+		f.add(IdleResourceFinding{
+			Kind:                 IdleNodeBalancerWithoutNodes,
+			EntityType:           EntityNodebalancer,
+			ID:                   strconv.Itoa(nodebalancer.ID),
+			Label:                label,
+			Region:               nodebalancer.Region,
+			Reason:               reason,
+			EstimatedMonthlyCost: cost.Monthly,
+		})
+	}
+
+	return nil
+}
+
+func (f *idleResourceFinder) findReservedIPs(ctx context.Context) error {
+	ips, err := f.client.ListReservedIPAddresses(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list reserved IP addresses: %w", err)
+	}
+
+	for _, ip := range ips {
+		if ip.LinodeID != 0 || ip.AssignedEntity != nil {
+			continue
+		}
+
+		cost, err := f.prices.reservedIP(ctx, ip.Region)
+		if err != nil {
+			return err
+		}
+
+		f.add(IdleResourceFinding{
+			Kind:                 IdleUnassignedReservedIP,
+			EntityType:           EntityIPAddress,
+			ID:                   ip.Address,
+			Label:                ip.Address,
+			Region:               ip.Region,
+			Reason:               "reserved IP address is not assigned to anything",
+			EstimatedMonthlyCost: cost.Monthly,
+		})
+	}
+
+	return nil
+}
+
+func (f *idleResourceFinder) findFirewalls(ctx context.Context) error {
+	firewalls, err := f.client.ListFirewalls(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list firewalls: %w", err)
+	}
+
+	for _, firewall := range firewalls {
+		if len(firewall.Entities) > 0 {
+			continue
+		}
+
+		f.add(IdleResourceFinding{
+			Kind:       IdleFirewallWithoutDevices,
+			EntityType: EntityFirewall,
+			ID:         strconv.Itoa(firewall.ID),
+			Label:      firewall.Label,
+			Reason:     "firewall has no devices",
+		})
+	}
+
+	return nil
+}
+
+func (f *idleResourceFinder) findInstancesAndImages(ctx context.Context) error {
+	instances, err := f.client.ListInstances(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list instances: %w", err)
+	}
+
+	usedImages := make(map[string]bool)
+
+	for _, instance := range instances {
+		usedImages[instance.Image] = true
+
+		if instance.Status != InstanceOffline {
+			continue
+		}
+
+		since, ok, err := f.poweredOffSince(ctx, instance)
+		if err != nil {
+			return err
+		}
+
+		reason := "instance has been powered off since before its oldest retained event"
+
+		switch {
+		case ok && f.now.Sub(since) < f.opts.PoweredOffAge:
+			continue
+		case ok:
+			reason = fmt.Sprintf("instance has been powered off since %s", since.Format(time.DateOnly))
+		case instance.Created != nil && f.now.Sub(*instance.Created) < f.opts.PoweredOffAge:
+			// Without a shutdown event, the instance can't have been powered off for longer than it existed
+			continue
+		}
+
+		// Powered off instances are still billed
+		backups := instance.Backups != nil && instance.Backups.Enabled
+
+		cost, err := f.prices.instance(ctx, instance.Type, instance.Region, backups)
+		if _, err = unpricedReason(err); err != nil {
+			return err
+		}
+
+		f.add(IdleResourceFinding{
+			Kind:                 IdlePoweredOffInstance,
+			EntityType:           EntityLinode,
+			ID:                   strconv.Itoa(instance.ID),
+			Label:                instance.Label,
+			Region:               instance.Region,
+			Reason:               reason,
+			EstimatedMonthlyCost: cost.Monthly,
+		})
+	}
+
+	images, err := f.client.ListImages(ctx, &ListOptions{Filter: `{"is_public": false}`})
+	if err != nil {
+		return fmt.Errorf("failed to list images: %w", err)
+	}
+
+	for _, image := range images {
+		if image.IsPublic || usedImages[image.ID] || image.Created == nil ||
+			f.now.Sub(*image.Created) < f.opts.UnusedImageAge {
+			continue
+		}
+
+		f.add(IdleResourceFinding{
+			Kind:       IdleUnusedImage,
+			EntityType: EntityImage,
+			ID:         image.ID,
+			Label:      image.Label,
+			Reason:     fmt.Sprintf("image created %s is not used by any instance", image.Created.Format(time.DateOnly)),
+		})
+	}
+
+	return nil
+}
+
+// poweredOffSince returns the time of the given instance's latest shutdown event,
+// or false if it has no shutdown events, i.e. the shutdown is older than the account's events.
+func (f *idleResourceFinder) poweredOffSince(ctx context.Context, instance Instance) (time.Time, bool, error) {
+	filter := Filter{
+		Order:   Descending,
+		OrderBy: "created",
+	}
+	filter.AddField(Eq, "action", ActionLinodeShutdown)
+	filter.AddField(Eq, "entity.id", instance.ID)
+	filter.AddField(Eq, "entity.type", EntityLinode)
+
+	filterStr, err := filter.MarshalJSON()
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	events, err := f.client.ListEvents(ctx, NewListOptions(1, string(filterStr)))
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("failed to list events of instance %d: %w", instance.ID, err)
+	}
+
+	for _, event := range events {
+		if event.Created != nil {
+			return *event.Created, true, nil
+		}
+	}
+
+	return time.Time{}, false, nil
+}
+
+func (f *idleResourceFinder) findObjectStorageKeys(ctx context.Context) error {
+	keys, err := f.client.ListObjectStorageKeys(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list Object Storage keys: %w", err)
+	}
+
+	for _, key := range keys {
+		// Unlimited keys have access to all buckets
+		if !key.Limited || (key.BucketAccess != nil && len(*key.BucketAccess) > 0) {
+			continue
+		}
+
+		f.add(IdleResourceFinding{
+			Kind:       IdleObjectStorageKeyNoAccess,
+			EntityType: EntityObjectStorageKey,
+			ID:         strconv.Itoa(key.ID),
+			Label:      key.Label,
+			Reason:     "limited Object Storage key has no bucket access",
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,550 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DefaultInformerEventResyncInterval is the default interval between full
+// relists of an Informer which is refreshed using the event stream.
+const DefaultInformerEventResyncInterval = 10 * time.Minute
+
+// InformerDeltaType is the type of change to an object observed by an Informer.
+type InformerDeltaType string
+
+// InformerDeltaType enums represent the changes observed by an Informer.
+const (
+	InformerAdded   InformerDeltaType = "added"
+	InformerUpdated InformerDeltaType = "updated"
+	InformerDeleted InformerDeltaType = "deleted"
+)
+
+// InformerDelta is a change to an object observed by an Informer.
+type InformerDelta[T any] struct {
+	Type InformerDeltaType
+
+	// Object is the current state of the object, or its last known state if it was deleted.
+	Object *T
+
+	// Previous is the previous state of an updated object, or nil for other deltas.
+	Previous *T
+}
+
+// InformerHandlerFunc handles a change observed by an Informer.
+type InformerHandlerFunc[T any] func(ctx context.Context, delta InformerDelta[T])
+
+// InformerOptions configures an Informer.
+type InformerOptions struct {
+	// ResyncInterval is the duration between full relists of the resource.
+	// Defaults to the client's poll delay, or DefaultInformerEventResyncInterval
+	// if EventRefresh is enabled.
+	ResyncInterval time.Duration
+
+	// EventRefresh enables refreshing the objects referenced by new events rather than
+	// waiting for the next full relist.
+	EventRefresh bool
+
+	// EventInterval is the duration between polls of the event stream if EventRefresh
+	// is enabled. Defaults to the client's poll delay.
+	EventInterval time.Duration
+
+	// OnError is called when a relist or refresh fails.
+	// By default, failures are logged.
+	OnError func(err error)
+}
+
+// Informer keeps a local, indexed copy of all objects of a resource type up to date,
+// notifying its handlers of added, updated and deleted objects.
+//
+// A single Informer is intended to be shared by all consumers of a resource type so that
+// only one poller is used. Objects are returned as deep copies, so modifying them doesn't
+// affect the informer's store or the objects received by other consumers.
+type Informer[T any] struct {
+	client Client
+	opts   InformerOptions
+	source informerSource[T]
+
+	lock    sync.RWMutex
+	items   map[int]*T
+	indexes map[string]map[string]map[int]struct{}
+	synced  bool
+
+	// revision is incremented whenever a relist or refresh starts. refreshed records the
+	// revision at which each object was last refreshed, and resynced the revision at which
+	// the last applied relist started, so that results older than the store are dropped.
+	revision  uint64
+	refreshed map[int]uint64
+	resynced  uint64
+
+	// handlerLock serializes changes to the store and the delivery of the resulting
+	// deltas so handlers observe them in order
+	handlerLock sync.Mutex
+	handlers    []InformerHandlerFunc[T]
+}
+
+// informerSource describes how an Informer lists, fetches and indexes the objects of a resource type.
+type informerSource[T any] struct {
+	entityType EntityType
+	list       func(ctx context.Context, c *Client) ([]T, error)
+	get        func(c *Client, ctx context.Context, id int) (*T, error)
+	id         func(T) int
+	label      func(T) string
+	region     func(T) string
+	tags       func(T) []string
+}
+
+const (
+	informerIndexLabel  = "label"
+	informerIndexRegion = "region"
+	informerIndexTag    = "tag"
+)
+
+// NewInstanceInformer creates a new Informer for the account's Linode instances.
+func (c *Client) NewInstanceInformer(opts *InformerOptions) *Informer[Instance] {
+	return newInformer(c, opts, informerSource[Instance]{
+		entityType: EntityLinode,
+		list: func(ctx context.Context, c *Client) ([]Instance, error) {
+			return c.ListInstances(ctx, nil)
+		},
+		get:    (*Client).GetInstance,
+		id:     func(i Instance) int { return i.ID },
+		label:  func(i Instance) string { return i.Label },
+		region: func(i Instance) string { return i.Region },
+		tags:   func(i Instance) []string { return i.Tags },
+	})
+}
+
+// NewVolumeInformer creates a new Informer for the account's volumes.
+func (c *Client) NewVolumeInformer(opts *InformerOptions) *Informer[Volume] {
+	return newInformer(c, opts, informerSource[Volume]{
+		entityType: EntityVolume,
+		list: func(ctx context.Context, c *Client) ([]Volume, error) {
+			return c.ListVolumes(ctx, nil)
+		},
+		get:    (*Client).GetVolume,
+		id:     func(v Volume) int { return v.ID },
+		label:  func(v Volume) string { return v.Label },
+		region: func(v Volume) string { return v.Region },
+		tags:   func(v Volume) []string { return v.Tags },
+	})
+}
+
+// NewNodeBalancerInformer creates a new Informer for the account's NodeBalancers.
+func (c *Client) NewNodeBalancerInformer(opts *InformerOptions) *Informer[NodeBalancer] {
+	return newInformer(c, opts, informerSource[NodeBalancer]{
+		entityType: EntityNodebalancer,
+		list: func(ctx context.Context, c *Client) ([]NodeBalancer, error) {
+			return c.ListNodeBalancers(ctx, nil)
+		},
+		get: (*Client).GetNodeBalancer,
+		id:  func(n NodeBalancer) int { return n.ID },
+		label: func(n NodeBalancer) string {
+			if n.Label == nil {
+				return ""
+			}
+
+			return *n.Label
+		},
+		region: func(n NodeBalancer) string { return n.Region },
+		tags:   func(n NodeBalancer) []string { return n.Tags },
+	})
+}
+
+func newInformer[T any](c *Client, opts *InformerOptions, source informerSource[T]) *Informer[T] {
+	result := &Informer[T]{
+		client:    *c,
+		source:    source,
+		items:     make(map[int]*T),
+		indexes:   make(map[string]map[string]map[int]struct{}),
+		refreshed: make(map[int]uint64),
+	}
+
+	if opts != nil {
+		result.opts = *opts
+	}
+
+	if result.opts.ResyncInterval <= 0 {
+		result.opts.ResyncInterval = c.GetPollDelay()
+
+		if result.opts.EventRefresh {
+			result.opts.ResyncInterval = DefaultInformerEventResyncInterval
+		}
+	}
+
+	if result.opts.EventInterval <= 0 {
+		result.opts.EventInterval = c.GetPollDelay()
+	}
+
+	return result
+}
+
+// AddHandler registers a handler to be notified of changes to the informer's objects.
+// If the informer has already synced, the handler is immediately notified of all
+// existing objects as added.
+func (i *Informer[T]) AddHandler(ctx context.Context, handler InformerHandlerFunc[T]) {
+	i.handlerLock.Lock()
+	defer i.handlerLock.Unlock()
+
+	i.handlers = append(i.handlers, handler)
+
+	for _, item := range i.List() {
+		handler(ctx, InformerDelta[T]{Type: InformerAdded, Object: &item})
+	}
+}
+
+// Run keeps the informer's store up to date until ctx is canceled.
+// An error is returned if the initial list fails; later failures are reported
+// using the OnError option and retried.
+func (i *Informer[T]) Run(ctx context.Context) error {
+	var subscriber *EventSubscriber
+
+	if i.opts.EventRefresh {
+		subscriber = i.client.NewEventSubscriber(&EventSubscriberOptions{
+			EntityTypes: []EntityType{i.source.entityType},
+		})
+
+		// Establish the subscriber's checkpoint before listing so no changes are missed
+		if err := subscriber.Poll(ctx, func(context.Context, EventUpdate) error { return nil }); err != nil {
+			return err
+		}
+	}
+
+	if err := i.Resync(ctx); err != nil {
+		return err
+	}
+
+	resync := time.NewTicker(i.opts.ResyncInterval)
+	defer resync.Stop()
+
+	var eventsC <-chan time.Time
+
+	if subscriber != nil {
+		events := time.NewTicker(i.opts.EventInterval)
+		defer events.Stop()
+
+		eventsC = events.C
+	}
+
+	for {
+		select {
+		case <-resync.C:
+			if err := i.Resync(ctx); err != nil {
+				i.reportError(err)
+			}
+		case <-eventsC:
+			if err := i.refreshFromEvents(ctx, subscriber); err != nil {
+				i.reportError(err)
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Resync relists all objects, updating the store and notifying handlers of any changes
+// in order of ID. Objects refreshed after the relist started keep their refreshed state.
+func (i *Informer[T]) Resync(ctx context.Context) error {
+	started := i.nextRevision()
+
+	items, err := i.source.list(ctx, &i.client)
+	if err != nil {
+		return fmt.Errorf("failed to list %ss: %w", i.source.entityType, err)
+	}
+
+	slices.SortFunc(items, func(a, b T) int {
+		return i.source.id(a) - i.source.id(b)
+	})
+
+	listed := make(map[int]struct{}, len(items))
+	deltas := make([]InformerDelta[T], 0)
+
+	i.handlerLock.Lock()
+	defer i.handlerLock.Unlock()
+
+	i.lock.Lock()
+
+	// A relist which started before the last applied relist is outdated
+	if started < i.resynced {
+		i.lock.Unlock()
+		return nil
+	}
+
+	for _, item := range items {
+		id := i.source.id(item)
+		listed[id] = struct{}{}
+
+		if i.refreshed[id] > started {
+			continue
+		}
+
+		if delta, ok := i.upsert(item); ok {
+			deltas = append(deltas, delta)
+		}
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(i.items)) {
+		if _, ok := listed[id]; !ok && i.refreshed[id] <= started {
+			deltas = append(deltas, i.remove(id))
+		}
+	}
+
+	// Refreshes started before the relist are superseded by it
+	maps.DeleteFunc(i.refreshed, func(_ int, revision uint64) bool {
+		return revision <= started
+	})
+
+	i.resynced = started
+	i.synced = true
+
+	i.lock.Unlock()
+
+	i.notify(ctx, deltas)
+
+	return nil
+}
+
+// Refresh fetches the object with the given ID, updating the store and notifying
+// handlers of any changes. The object is removed from the store if it no longer exists.
+func (i *Informer[T]) Refresh(ctx context.Context, id int) error {
+	started := i.nextRevision()
+
+	item, err := i.source.get(&i.client, ctx, id)
+	if err != nil && !IsNotFound(err) {
+		return fmt.Errorf("failed to get %s %d: %w", i.source.entityType, id, err)
+	}
+
+	var (
+		delta InformerDelta[T]
+		ok    bool
+	)
+
+	i.handlerLock.Lock()
+	defer i.handlerLock.Unlock()
+
+	i.lock.Lock()
+
+	// The object may have been updated by a relist or refresh which started later
+	if started > i.resynced && started > i.refreshed[id] {
+		i.refreshed[id] = started
+
+		if item != nil {
+			delta, ok = i.upsert(*item)
+		} else if _, exists := i.items[id]; exists {
+			delta, ok = i.remove(id), true
+		}
+	}
+
+	i.lock.Unlock()
+
+	if ok {
+		i.notify(ctx, []InformerDelta[T]{delta})
+	}
+
+	return nil
+}
+
+// nextRevision increments and returns the informer's revision.
+func (i *Informer[T]) nextRevision() uint64 {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	i.revision++
+
+	return i.revision
+}
+
+// HasSynced returns whether the informer has completed its initial list.
+func (i *Informer[T]) HasSynced() bool {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	return i.synced
+}
+
+// Get returns the object with the given ID, if present.
+func (i *Informer[T]) Get(id int) (*T, bool) {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	item, ok := i.items[id]
+	if !ok {
+		return nil, false
+	}
+
+	return deepCopy(item), true
+}
+
+// List returns all objects in order of ID.
+func (i *Informer[T]) List() []T {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	return i.collect(slices.Collect(maps.Keys(i.items)))
+}
+
+// ByLabel returns the objects with the given label in order of ID.
+func (i *Informer[T]) ByLabel(label string) []T {
+	return i.byIndex(informerIndexLabel, label)
+}
+
+// ByRegion returns the objects in the given region in order of ID.
+func (i *Informer[T]) ByRegion(region string) []T {
+	return i.byIndex(informerIndexRegion, region)
+}
+
+// ByTag returns the objects with the given tag in order of ID.
+func (i *Informer[T]) ByTag(tag string) []T {
+	return i.byIndex(informerIndexTag, tag)
+}
+
+func (i *Informer[T]) byIndex(index, value string) []T {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	return i.collect(slices.Collect(maps.Keys(i.indexes[index][value])))
+}
+
+// collect returns copies of the objects with the given IDs in order of ID.
+// The caller must hold the informer's lock.
+func (i *Informer[T]) collect(ids []int) []T {
+	slices.Sort(ids)
+
+	result := make([]T, len(ids))
+	for j, id := range ids {
+		result[j] = *deepCopy(i.items[id])
+	}
+
+	return result
+}
+
+// refreshFromEvents refreshes the objects referenced by new events.
+func (i *Informer[T]) refreshFromEvents(ctx context.Context, subscriber *EventSubscriber) error {
+	ids := make(map[int]struct{})
+
+	err := subscriber.Poll(ctx, func(_ context.Context, update EventUpdate) error {
+		if update.Event.Entity == nil || update.Event.Entity.Type != i.source.entityType {
+			return nil
+		}
+
+		id, err := strconv.Atoi(formatEventEntityID(update.Event.Entity.ID))
+		if err != nil {
+			return nil
+		}
+
+		ids[id] = struct{}{}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, id := range slices.Sorted(maps.Keys(ids)) {
+		if err := i.Refresh(ctx, id); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// upsert stores the given object, returning the resulting delta if it changed.
+// The caller must hold the informer's lock.
+func (i *Informer[T]) upsert(item T) (InformerDelta[T], bool) {
+	id := i.source.id(item)
+	previous, exists := i.items[id]
+
+	if exists && reflect.DeepEqual(*previous, item) {
+		return InformerDelta[T]{}, false
+	}
+
+	if exists {
+		i.unindex(id, *previous)
+	}
+
+	i.items[id] = &item
+	i.index(id, item)
+
+	if !exists {
+		return InformerDelta[T]{Type: InformerAdded, Object: &item}, true
+	}
+
+	return InformerDelta[T]{Type: InformerUpdated, Object: &item, Previous: previous}, true
+}
+
+// remove removes the object with the given ID, returning the resulting delta.
+// The caller must hold the informer's lock.
+func (i *Informer[T]) remove(id int) InformerDelta[T] {
+	item := i.items[id]
+
+	i.unindex(id, *item)
+	delete(i.items, id)
+
+	return InformerDelta[T]{Type: InformerDeleted, Object: item}
+}
+
+func (i *Informer[T]) indexValues(item T) map[string][]string {
+	return map[string][]string{
+		informerIndexLabel:  {i.source.label(item)},
+		informerIndexRegion: {i.source.region(item)},
+		informerIndexTag:    i.source.tags(item),
+	}
+}
+
+func (i *Informer[T]) index(id int, item T) {
+	for index, values := range i.indexValues(item) {
+		if i.indexes[index] == nil {
+			i.indexes[index] = make(map[string]map[int]struct{})
+		}
+
+		for _, value := range values {
+			if i.indexes[index][value] == nil {
+				i.indexes[index][value] = make(map[int]struct{})
+			}
+
+			i.indexes[index][value][id] = struct{}{}
+		}
+	}
+}
+
+func (i *Informer[T]) unindex(id int, item T) {
+	for index, values := range i.indexValues(item) {
+		for _, value := range values {
+			delete(i.indexes[index][value], id)
+
+			if len(i.indexes[index][value]) == 0 {
+				delete(i.indexes[index], value)
+			}
+		}
+	}
+}
+
+// notify delivers the given deltas to all handlers in order.
+// Each handler receives its own copy of the deltas, so it can't modify the store or the
+// deltas received by other handlers. The caller must hold the informer's handler lock.
+func (i *Informer[T]) notify(ctx context.Context, deltas []InformerDelta[T]) {
+	for _, delta := range deltas {
+		for _, handler := range i.handlers {
+			handler(ctx, deepCopy(delta))
+		}
+	}
+}
+
+func (i *Informer[T]) reportError(err error) {
+	if i.opts.OnError != nil {
+		i.opts.OnError(err)
+		return
+	}
+
+	log.Printf("[WARN] Informer failed to refresh %ss: %s", i.source.entityType, err)
+}
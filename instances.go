@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net"
 	"time"
 
@@ -402,6 +403,12 @@ func (c *Client) ListInstances(ctx context.Context, opts *ListOptions) ([]Instan
 	return getPaginatedResults[Instance](ctx, c, "linode/instances", opts)
 }
 
+// IterateInstances returns an iterator over linode instances, requesting each page as the
+// previous one is consumed rather than holding every instance in memory.
+func (c *Client) IterateInstances(ctx context.Context, opts *ListOptions) iter.Seq2[Instance, error] {
+	return iteratePaginatedResults[Instance](ctx, c, "linode/instances", opts)
+}
+
 // GetInstance gets the instance with the provided ID
 func (c *Client) GetInstance(ctx context.Context, linodeID int) (*Instance, error) {
 	e := formatAPIPath("linode/instances/%d", linodeID)
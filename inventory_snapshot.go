@@ -0,0 +1,522 @@
+package linodego
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// InventorySnapshotVersion is the version of the snapshot format written by TakeInventorySnapshot(...).
+const InventorySnapshotVersion = 1
+
+// InventorySnapshot is a point-in-time record of the resources on an account.
+type InventorySnapshot struct {
+	// Version is the version of the snapshot format.
+	Version int `json:"version"`
+
+	// TakenAt is the time the snapshot was started.
+	TakenAt time.Time `json:"taken_at"`
+
+	// Resources maps resource types, e.g. "instances", to the resources of that type keyed by
+	// their identifier. Resources are stored as the JSON encoding of the corresponding linodego
+	// types, so fields this version of linodego doesn't know about are not included.
+	Resources map[string]map[string]json.RawMessage `json:"resources"`
+
+	// Errors maps the resource types which could not be listed to the reason they failed.
+	Errors map[string]string `json:"errors,omitzero"`
+}
+
+// InventorySnapshotOptions configures TakeInventorySnapshot(...).
+type InventorySnapshotOptions struct {
+	// ResourceTypes restricts the snapshot to the given resource types, e.g. "instances".
+	// Defaults to all resource types listed by InventoryResourceTypes().
+	ResourceTypes []string
+
+	// Concurrency is the maximum number of resource types listed concurrently.
+	// Defaults to DefaultBatchConcurrency.
+	Concurrency int
+}
+
+type inventoryCollector func(ctx context.Context, client *Client) (map[string]json.RawMessage, error)
+
+// inventoryCollectors lists the account's resources of each type keyed by their identifier.
+// Child resources, e.g. domain records, are keyed by their parent's identifier followed by
+// their own, e.g. "123/456". Public images and StackScripts are excluded, as they don't
+// belong to the account.
+var inventoryCollectors = map[string]inventoryCollector{
+	"domain_records":         inventoryNested(inventoryParents((*Client).ListDomains), func(v Domain) int { return v.ID }, (*Client).ListDomainRecords, func(v DomainRecord) string { return strconv.Itoa(v.ID) }),
+	"domains":                inventoryList((*Client).ListDomains, nil, func(v Domain) string { return strconv.Itoa(v.ID) }),
+	"firewalls":              inventoryList((*Client).ListFirewalls, nil, func(v Firewall) string { return strconv.Itoa(v.ID) }),
+	"images":                 inventoryList((*Client).ListImages, &ListOptions{Filter: `{"is_public": false}`}, func(v Image) string { return v.ID }),
+	"instance_configs":       inventoryNested(inventoryParents((*Client).ListInstances), func(v Instance) int { return v.ID }, (*Client).ListInstanceConfigs, func(v InstanceConfig) string { return strconv.Itoa(v.ID) }),
+	"instance_disks":         inventoryNested(inventoryParents((*Client).ListInstances), func(v Instance) int { return v.ID }, (*Client).ListInstanceDisks, func(v InstanceDisk) string { return strconv.Itoa(v.ID) }),
+	"instances":              inventoryList((*Client).ListInstances, nil, func(v Instance) string { return strconv.Itoa(v.ID) }),
+	"ip_addresses":           inventoryList((*Client).ListIPAddresses, nil, func(v InstanceIP) string { return v.Address }),
+	"lke_clusters":           inventoryList((*Client).ListLKEClusters, nil, func(v LKECluster) string { return strconv.Itoa(v.ID) }),
+	"lke_node_pools":         inventoryNested(inventoryParents((*Client).ListLKEClusters), func(v LKECluster) int { return v.ID }, (*Client).ListLKENodePools, func(v LKENodePool) string { return strconv.Itoa(v.ID) }),
+	"longview_clients":       inventoryList((*Client).ListLongviewClients, nil, func(v LongviewClient) string { return strconv.Itoa(v.ID) }),
+	"mysql_databases":        inventoryList((*Client).ListMySQLDatabases, nil, func(v MySQLDatabase) string { return strconv.Itoa(v.ID) }),
+	"nodebalancer_configs":   inventoryNested(inventoryParents((*Client).ListNodeBalancers), func(v NodeBalancer) int { return v.ID }, (*Client).ListNodeBalancerConfigs, func(v NodeBalancerConfig) string { return strconv.Itoa(v.ID) }),
+	"nodebalancer_nodes":     inventoryNodeBalancerNodes,
+	"nodebalancers":          inventoryList((*Client).ListNodeBalancers, nil, func(v NodeBalancer) string { return strconv.Itoa(v.ID) }),
+	"oauth_clients":          inventoryList((*Client).ListOAuthClients, nil, func(v OAuthClient) string { return v.ID }),
+	"object_storage_buckets": inventoryList((*Client).ListObjectStorageBuckets, nil, func(v ObjectStorageBucket) string { return v.Region + "/" + v.Label }),
+	"object_storage_keys":    inventoryList((*Client).ListObjectStorageKeys, nil, func(v ObjectStorageKey) string { return strconv.Itoa(v.ID) }),
+	"placement_groups":       inventoryList((*Client).ListPlacementGroups, nil, func(v PlacementGroup) string { return strconv.Itoa(v.ID) }),
+	"postgres_databases":     inventoryList((*Client).ListPostgresDatabases, nil, func(v PostgresDatabase) string { return strconv.Itoa(v.ID) }),
+	"ssh_keys":               inventoryList((*Client).ListSSHKeys, nil, func(v SSHKey) string { return strconv.Itoa(v.ID) }),
+	"stackscripts":           inventoryList((*Client).ListStackscripts, &ListOptions{Filter: `{"mine": true}`}, func(v Stackscript) string { return strconv.Itoa(v.ID) }),
+	"tokens":                 inventoryList((*Client).ListTokens, nil, func(v Token) string { return strconv.Itoa(v.ID) }),
+	"users":                  inventoryList((*Client).ListUsers, nil, func(v User) string { return v.Username }),
+	"vlans":                  inventoryList((*Client).ListVLANs, nil, func(v VLAN) string { return v.Region + "/" + v.Label }),
+	"volumes":                inventoryList((*Client).ListVolumes, nil, func(v Volume) string { return strconv.Itoa(v.ID) }),
+	"vpcs":                   inventoryList((*Client).ListVPCs, nil, func(v VPC) string { return strconv.Itoa(v.ID) }),
+}
+
+func inventoryList[T any](
+	list func(c *Client, ctx context.Context, opts *ListOptions) ([]T, error),
+	opts *ListOptions,
+	key func(item T) string,
+) inventoryCollector {
+	return func(ctx context.Context, client *Client) (map[string]json.RawMessage, error) {
+		var listOpts *ListOptions
+		if opts != nil {
+			// Don't share the options between snapshots, as the client updates them while paginating
+			copied := *opts
+			listOpts = &copied
+		}
+
+		items, err := list(client, ctx, listOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		result := make(map[string]json.RawMessage, len(items))
+
+		if err := inventoryAdd(result, "", items, key); err != nil {
+			return nil, err
+		}
+
+		return result, nil
+	}
+}
+
+// inventoryParents adapts the given list function to list all of the parents of a nested resource type.
+func inventoryParents[P any](
+	list func(c *Client, ctx context.Context, opts *ListOptions) ([]P, error),
+) func(ctx context.Context, client *Client) ([]P, error) {
+	return func(ctx context.Context, client *Client) ([]P, error) {
+		return list(client, ctx, nil)
+	}
+}
+
+// inventoryNested lists the children of every parent returned by parents,
+// keying each child by its parent's ID followed by its own key.
+func inventoryNested[P, T any](
+	parents func(ctx context.Context, client *Client) ([]P, error),
+	parentID func(parent P) int,
+	list func(c *Client, ctx context.Context, parentID int, opts *ListOptions) ([]T, error),
+	key func(item T) string,
+) inventoryCollector {
+	return func(ctx context.Context, client *Client) (map[string]json.RawMessage, error) {
+		items, err := parents(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+
+		result := make(map[string]json.RawMessage)
+
+		for _, parent := range items {
+			id := parentID(parent)
+
+			children, err := list(client, ctx, id, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := inventoryAdd(result, strconv.Itoa(id)+"/", children, key); err != nil {
+				return nil, err
+			}
+		}
+
+		return result, nil
+	}
+}
+
+// inventoryNodeBalancerNodes lists the nodes of every NodeBalancer config,
+// keyed by the NodeBalancer's ID, the config's ID and the node's ID, e.g. "1/2/3".
+func inventoryNodeBalancerNodes(ctx context.Context, client *Client) (map[string]json.RawMessage, error) {
+	nodeBalancers, err := client.ListNodeBalancers(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]json.RawMessage)
+
+	for _, nodeBalancer := range nodeBalancers {
+		configs, err := client.ListNodeBalancerConfigs(ctx, nodeBalancer.ID, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, config := range configs {
+			nodes, err := client.ListNodeBalancerNodes(ctx, nodeBalancer.ID, config.ID, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			prefix := fmt.Sprintf("%d/%d/", nodeBalancer.ID, config.ID)
+
+			if err := inventoryAdd(result, prefix, nodes, func(v NodeBalancerNode) string { return strconv.Itoa(v.ID) }); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// inventoryAdd adds the given items to result, keyed by prefix followed by their key.
+func inventoryAdd[T any](result map[string]json.RawMessage, prefix string, items []T, key func(item T) string) error {
+	for _, item := range items {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return err
+		}
+
+		result[prefix+key(item)] = data
+	}
+
+	return nil
+}
+
+// InventoryResourceTypes returns the resource types captured by TakeInventorySnapshot(...), sorted by name.
+func InventoryResourceTypes() []string {
+	return slices.Sorted(maps.Keys(inventoryCollectors))
+}
+
+// TakeInventorySnapshot lists the account's resources of every supported type concurrently
+// and returns them as a single snapshot.
+//
+// Resource types which fail to list, e.g. due to missing permissions, are recorded in the
+// snapshot's Errors rather than returned as an error, so that the remaining types are still
+// captured. An error is returned if opts contains an unsupported resource type.
+func (c *Client) TakeInventorySnapshot(ctx context.Context, opts *InventorySnapshotOptions) (*InventorySnapshot, error) {
+	if opts == nil {
+		opts = &InventorySnapshotOptions{}
+	}
+
+	resourceTypes := opts.ResourceTypes
+	if len(resourceTypes) == 0 {
+		resourceTypes = InventoryResourceTypes()
+	}
+
+	collected := make([]map[string]json.RawMessage, len(resourceTypes))
+	operations := make([]BatchOperation, len(resourceTypes))
+
+	for i, resourceType := range resourceTypes {
+		collector, ok := inventoryCollectors[resourceType]
+		if !ok {
+			return nil, fmt.Errorf("unsupported inventory resource type %s", resourceType)
+		}
+
+		operations[i] = BatchOperation{
+			Key: resourceType,
+			Run: func(ctx context.Context, client *Client) (err error) {
+				collected[i], err = collector(ctx, client)
+				return err
+			},
+		}
+	}
+
+	snapshot := &InventorySnapshot{
+		Version:   InventorySnapshotVersion,
+		TakenAt:   time.Now().UTC(),
+		Resources: make(map[string]map[string]json.RawMessage, len(resourceTypes)),
+	}
+
+	report, err := c.RunBatch(ctx, operations, &BatchOptions{Concurrency: opts.Concurrency})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, result := range report.Results {
+		if result.Status == BatchSucceeded {
+			snapshot.Resources[result.Key] = collected[i]
+			continue
+		}
+
+		if snapshot.Errors == nil {
+			snapshot.Errors = make(map[string]string)
+		}
+
+		snapshot.Errors[result.Key] = result.Err.Error()
+	}
+
+	return snapshot, nil
+}
+
+// ParseInventorySnapshot parses a snapshot previously written by WriteTo(...).
+func ParseInventorySnapshot(data []byte) (*InventorySnapshot, error) {
+	var snapshot InventorySnapshot
+
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to parse inventory snapshot: %w", err)
+	}
+
+	if snapshot.Version != InventorySnapshotVersion {
+		return nil, fmt.Errorf("unsupported inventory snapshot version %d", snapshot.Version)
+	}
+
+	return &snapshot, nil
+}
+
+// LoadInventorySnapshot reads and parses the snapshot at the given path.
+func LoadInventorySnapshot(path string) (*InventorySnapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read inventory snapshot %s: %w", path, err)
+	}
+
+	return ParseInventorySnapshot(data)
+}
+
+// WriteTo writes the snapshot to w as indented JSON.
+func (s *InventorySnapshot) WriteTo(w io.Writer) (int64, error) {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal inventory snapshot: %w", err)
+	}
+
+	n, err := w.Write(append(data, '\n'))
+
+	return int64(n), err
+}
+
+// Save atomically writes the snapshot to the given path.
+func (s *InventorySnapshot) Save(path string) error {
+	return writeFileAtomic(path, func(w io.Writer) error {
+		_, err := s.WriteTo(w)
+		return err
+	})
+}
+
+// InventoryChangeType is the kind of change made to a resource between two snapshots.
+type InventoryChangeType string
+
+// InventoryChangeType enums represent the kinds of changes made to a resource between two snapshots.
+const (
+	InventoryResourceAdded    InventoryChangeType = "added"
+	InventoryResourceRemoved  InventoryChangeType = "removed"
+	InventoryResourceModified InventoryChangeType = "modified"
+)
+
+// InventoryFieldChange is a change to a field of a modified resource.
+type InventoryFieldChange struct {
+	// Field is the path of the field, with nested fields separated by dots, e.g. "specs.disk".
+	Field string `json:"field"`
+	Old   any    `json:"old"`
+	New   any    `json:"new"`
+}
+
+// InventoryChange is a resource which was added, removed or modified between two snapshots.
+type InventoryChange struct {
+	Type         InventoryChangeType `json:"type"`
+	ResourceType string              `json:"resource_type"`
+	Key          string              `json:"key"`
+	Label        string              `json:"label,omitzero"`
+
+	// Fields contains the field-level changes of a modified resource.
+	Fields []InventoryFieldChange `json:"fields,omitzero"`
+}
+
+// InventoryDiff is the set of changes between two snapshots.
+type InventoryDiff struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+
+	// Changes is ordered by resource type and key.
+	Changes []InventoryChange `json:"changes"`
+
+	// Incomplete lists the resource types which could not be compared because they are
+	// missing from either snapshot, e.g. because they failed to list.
+	Incomplete []string `json:"incomplete,omitzero"`
+}
+
+// Empty returns whether no changes were found between the snapshots.
+func (d *InventoryDiff) Empty() bool {
+	return len(d.Changes) == 0
+}
+
+// DiffInventorySnapshots compares two snapshots and returns the resources which were added,
+// removed or modified between them, including the changed fields of modified resources.
+func DiffInventorySnapshots(from, to *InventorySnapshot) (*InventoryDiff, error) {
+	if from.Version != to.Version {
+		return nil, fmt.Errorf("can't compare inventory snapshots of versions %d and %d", from.Version, to.Version)
+	}
+
+	diff := &InventoryDiff{
+		From:    from.TakenAt,
+		To:      to.TakenAt,
+		Changes: make([]InventoryChange, 0),
+	}
+
+	resourceTypes := slices.Sorted(maps.Keys(from.Resources))
+	for resourceType := range to.Resources {
+		if _, ok := from.Resources[resourceType]; !ok {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+	}
+
+	slices.Sort(resourceTypes)
+
+	for _, resourceType := range resourceTypes {
+		fromResources, fromOK := from.Resources[resourceType]
+		toResources, toOK := to.Resources[resourceType]
+
+		if !fromOK || !toOK {
+			diff.Incomplete = append(diff.Incomplete, resourceType)
+			continue
+		}
+
+		changes, err := diffInventoryResources(resourceType, fromResources, toResources)
+		if err != nil {
+			return nil, err
+		}
+
+		diff.Changes = append(diff.Changes, changes...)
+	}
+
+	return diff, nil
+}
+
+func diffInventoryResources(resourceType string, from, to map[string]json.RawMessage) ([]InventoryChange, error) {
+	keys := slices.Collect(maps.Keys(from))
+	for key := range to {
+		if _, ok := from[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	slices.SortFunc(keys, compareInventoryKeys)
+
+	result := make([]InventoryChange, 0)
+
+	for _, key := range keys {
+		fromData, fromOK := from[key]
+		toData, toOK := to[key]
+
+		fromFields, err := flattenInventoryResource(fromData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s %s: %w", resourceType, key, err)
+		}
+
+		toFields, err := flattenInventoryResource(toData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s %s: %w", resourceType, key, err)
+		}
+
+		change := InventoryChange{ResourceType: resourceType, Key: key}
+
+		switch {
+		case !fromOK:
+			change.Type = InventoryResourceAdded
+			change.Label, _ = toFields["label"].(string)
+		case !toOK:
+			change.Type = InventoryResourceRemoved
+			change.Label, _ = fromFields["label"].(string)
+		default:
+			change.Type = InventoryResourceModified
+			change.Label, _ = toFields["label"].(string)
+			change.Fields = diffInventoryFields(fromFields, toFields)
+
+			if len(change.Fields) == 0 {
+				continue
+			}
+		}
+
+		result = append(result, change)
+	}
+
+	return result, nil
+}
+
+// compareInventoryKeys orders numeric keys numerically and all other keys lexically.
+func compareInventoryKeys(a, b string) int {
+	aID, aErr := strconv.Atoi(a)
+	bID, bErr := strconv.Atoi(b)
+
+	if aErr == nil && bErr == nil {
+		return aID - bID
+	}
+
+	return strings.Compare(a, b)
+}
+
+func diffInventoryFields(from, to map[string]any) []InventoryFieldChange {
+	fields := slices.Collect(maps.Keys(from))
+	for field := range to {
+		if _, ok := from[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+
+	slices.Sort(fields)
+
+	result := make([]InventoryFieldChange, 0)
+
+	for _, field := range fields {
+		if !reflect.DeepEqual(from[field], to[field]) {
+			result = append(result, InventoryFieldChange{Field: field, Old: from[field], New: to[field]})
+		}
+	}
+
+	return result
+}
+
+// flattenInventoryResource decodes the given resource into a map of field paths to values,
+// where nested objects are flattened into dotted paths and lists are compared as a whole.
+func flattenInventoryResource(data json.RawMessage) (map[string]any, error) {
+	result := make(map[string]any)
+
+	if data == nil {
+		return result, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	flattenInventoryValue("", value, result)
+
+	return result, nil
+}
+
+func flattenInventoryValue(path string, value any, result map[string]any) {
+	object, ok := value.(map[string]any)
+	if !ok || len(object) == 0 {
+		result[path] = value
+		return
+	}
+
+	for key, nested := range object {
+		if path != "" {
+			key = path + "." + key
+		}
+
+		flattenInventoryValue(key, nested, result)
+	}
+}
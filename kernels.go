@@ -43,40 +43,12 @@ func (i *LinodeKernel) UnmarshalJSON(b []byte) error {
 
 // ListKernels lists linode kernels. This endpoint is cached by default.
 func (c *Client) ListKernels(ctx context.Context, opts *ListOptions) ([]LinodeKernel, error) {
-	endpoint, err := generateListCacheURL("linode/kernels", opts)
-	if err != nil {
-		return nil, err
-	}
-
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]LinodeKernel), nil
-	}
-
-	response, err := getPaginatedResults[LinodeKernel](ctx, c, "linode/kernels", opts)
-	if err != nil {
-		return nil, err
-	}
-
-	c.addCachedResponse(endpoint, response, nil)
-
-	return response, nil
+	return getCachedPaginatedResults[LinodeKernel](ctx, c, "linode/kernels", opts, nil)
 }
 
 // GetKernel gets the kernel with the provided ID. This endpoint is cached by default.
 func (c *Client) GetKernel(ctx context.Context, kernelID string) (*LinodeKernel, error) {
 	e := formatAPIPath("linode/kernels/%s", kernelID)
 
-	if result := c.getCachedResponse(e); result != nil {
-		result := result.(LinodeKernel)
-		return &result, nil
-	}
-
-	response, err := doGETRequest[LinodeKernel](ctx, c, e)
-	if err != nil {
-		return nil, err
-	}
-
-	c.addCachedResponse(e, response, nil)
-
-	return response, nil
+	return doCachedGETRequest[LinodeKernel](ctx, c, e, nil)
 }
@@ -179,42 +179,14 @@ func (i LKECluster) GetUpdateOptions() (o LKEClusterUpdateOptions) {
 func (c *Client) ListLKEVersions(ctx context.Context, opts *ListOptions) ([]LKEVersion, error) {
 	e := "lke/versions"
 
-	endpoint, err := generateListCacheURL(e, opts)
-	if err != nil {
-		return nil, err
-	}
-
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]LKEVersion), nil
-	}
-
-	response, err := getPaginatedResults[LKEVersion](ctx, c, e, opts)
-	if err != nil {
-		return nil, err
-	}
-
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
-
-	return response, nil
+	return getCachedPaginatedResults[LKEVersion](ctx, c, e, opts, &cacheExpiryTime)
 }
 
 // GetLKEVersion gets details about a specific LKE Version. This endpoint is cached by default.
 func (c *Client) GetLKEVersion(ctx context.Context, version string) (*LKEVersion, error) {
 	e := formatAPIPath("lke/versions/%s", version)
 
-	if result := c.getCachedResponse(e); result != nil {
-		result := result.(LKEVersion)
-		return &result, nil
-	}
-
-	response, err := doGETRequest[LKEVersion](ctx, c, e)
-	if err != nil {
-		return nil, err
-	}
-
-	c.addCachedResponse(e, response, &cacheExpiryTime)
-
-	return response, nil
+	return doCachedGETRequest[LKEVersion](ctx, c, e, &cacheExpiryTime)
 }
 
 // ListLKETierVersions lists all Kubernetes versions available given tier through LKE.
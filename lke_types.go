@@ -27,21 +27,5 @@ type LKETypeRegionPrice struct {
 func (c *Client) ListLKETypes(ctx context.Context, opts *ListOptions) ([]LKEType, error) {
 	e := "lke/types"
 
-	endpoint, err := generateListCacheURL(e, opts)
-	if err != nil {
-		return nil, err
-	}
-
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]LKEType), nil
-	}
-
-	response, err := getPaginatedResults[LKEType](ctx, c, e, opts)
-	if err != nil {
-		return nil, err
-	}
-
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
-
-	return response, nil
+	return getCachedPaginatedResults[LKEType](ctx, c, e, opts, &cacheExpiryTime)
 }
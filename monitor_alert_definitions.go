@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"iter"
 	"net/http"
 	"time"
 
@@ -224,6 +225,16 @@ func (c *Client) ListAllMonitorAlertDefinitions(
 	return getPaginatedResults[AlertDefinition](ctx, c, endpoint, opts)
 }
 
+// IterateAllMonitorAlertDefinitions returns an iterator over all ACLP Monitor Alert Definitions
+// under this account, requesting each page as the previous one is consumed.
+func (c *Client) IterateAllMonitorAlertDefinitions(
+	ctx context.Context,
+	opts *ListOptions,
+) iter.Seq2[AlertDefinition, error] {
+	endpoint := formatAPIPath("monitor/alert-definitions")
+	return iteratePaginatedResults[AlertDefinition](ctx, c, endpoint, opts)
+}
+
 // GetMonitorAlertDefinition gets an ACLP Monitor Alert Definition.
 func (c *Client) GetMonitorAlertDefinition(
 	ctx context.Context,
@@ -0,0 +1,320 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// MultiClientTarget is a single account targeted by a MultiClient.
+type MultiClientTarget struct {
+	// Name identifies the target, e.g. the config profile name or the child account EUUID.
+	Name string
+
+	// Client is the client used to make requests against the target account.
+	Client *Client
+}
+
+// MultiClient runs requests concurrently across multiple accounts,
+// e.g. every profile in a Linode config file or every child account of a parent account.
+type MultiClient struct {
+	Targets []MultiClientTarget
+
+	// Concurrency is the maximum number of targets queried concurrently.
+	// A value <= 0 queries all targets concurrently.
+	Concurrency int
+
+	// close releases any resources held for the targets, e.g. child account proxy tokens.
+	close func(ctx context.Context) error
+}
+
+// Close releases any resources held for the MultiClient's targets, such as the
+// proxy tokens created by NewMultiClientFromChildAccounts(...).
+// The targets' Clients should not be used after the MultiClient is closed.
+func (mc *MultiClient) Close(ctx context.Context) error {
+	if mc.close == nil {
+		return nil
+	}
+
+	return mc.close(ctx)
+}
+
+// MultiClientItem is a single result returned from a MultiClient target.
+type MultiClientItem[T any] struct {
+	// Target is the name of the target the item was returned from.
+	Target string
+	Item   T
+}
+
+// MultiClientError is returned when a MultiClient request fails for one or more targets.
+type MultiClientError struct {
+	// Errors maps the names of failed targets to their errors.
+	Errors map[string]error
+}
+
+func (e *MultiClientError) Error() string {
+	targets := make([]string, 0, len(e.Errors))
+	for target := range e.Errors {
+		targets = append(targets, target)
+	}
+
+	sort.Strings(targets)
+
+	messages := make([]string, len(targets))
+	for i, target := range targets {
+		messages[i] = fmt.Sprintf("%s: %s", target, e.Errors[target])
+	}
+
+	return fmt.Sprintf("request failed for %d target(s): %s", len(targets), strings.Join(messages, "; "))
+}
+
+// Unwrap returns the errors of all failed targets.
+func (e *MultiClientError) Unwrap() []error {
+	result := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		result = append(result, err)
+	}
+
+	return result
+}
+
+// NewMultiClientFromConfig creates a MultiClient targeting the given profiles of the
+// Linode config at the given path. If no profiles are specified, all profiles are targeted.
+// Each target's Client is created using a copy of the given http.Client.
+func NewMultiClientFromConfig(hc *http.Client, configPath string, profiles ...string) (*MultiClient, error) {
+	if configPath == "" {
+		resolved, err := resolveValidConfigPath()
+		if err != nil {
+			return nil, err
+		}
+
+		if resolved == "" {
+			return nil, fmt.Errorf("no linode config file found")
+		}
+
+		configPath = resolved
+	}
+
+	configProfiles, err := loadConfigProfiles(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return newMultiClientFromProfiles(hc, configProfiles, profiles)
+}
+
+// NewMultiClientFromProfiles creates a MultiClient targeting the given profiles loaded
+// by this client using LoadConfig(...). If no profiles are specified, all loaded profiles are targeted.
+func (c *Client) NewMultiClientFromProfiles(profiles ...string) (*MultiClient, error) {
+	if len(c.configProfiles) == 0 {
+		return nil, fmt.Errorf("no config profiles have been loaded")
+	}
+
+	return newMultiClientFromProfiles(c.httpClient, c.configProfiles, profiles)
+}
+
+func newMultiClientFromProfiles(
+	hc *http.Client,
+	configProfiles map[string]ConfigProfile,
+	profiles []string,
+) (*MultiClient, error) {
+	if len(profiles) == 0 {
+		for name, profile := range configProfiles {
+			// Skip profiles that cannot be used, such as an empty default section
+			if profile.APIToken == "" {
+				continue
+			}
+
+			profiles = append(profiles, name)
+		}
+
+		sort.Strings(profiles)
+	}
+
+	result := &MultiClient{
+		Targets: make([]MultiClientTarget, 0, len(profiles)),
+	}
+
+	for _, name := range profiles {
+		// Each profile may configure its own timeout and root CA, so each target
+		// needs its own http.Client
+		client, err := NewClient(copyHTTPClient(hc))
+		if err != nil {
+			return nil, err
+		}
+
+		client.configProfiles = configProfiles
+
+		if err = client.UseProfile(name); err != nil {
+			return nil, fmt.Errorf("failed to use profile %s: %w", name, err)
+		}
+
+		result.Targets = append(result.Targets, MultiClientTarget{Name: strings.ToLower(name), Client: &client})
+	}
+
+	return result, nil
+}
+
+// copyHTTPClient returns a shallow copy of the given http.Client with a cloned Transport,
+// so that the copy can be configured without affecting the original.
+func copyHTTPClient(hc *http.Client) *http.Client {
+	if hc == nil {
+		return nil
+	}
+
+	result := *hc
+
+	if transport, ok := hc.Transport.(*http.Transport); ok {
+		result.Transport = transport.Clone()
+	}
+
+	return &result
+}
+
+// NewMultiClientFromChildAccounts creates a MultiClient targeting all child accounts
+// of the current account. A proxy token is created for each child account using a
+// ChildAccountClientFactory, and each target is named using the child account's EUUID.
+//
+// The MultiClient should be closed once it is no longer needed to revoke the proxy tokens.
+// NOTE: Parent/Child related features may not be generally available.
+func (c *Client) NewMultiClientFromChildAccounts(ctx context.Context, opts *ListOptions) (*MultiClient, error) {
+	factory := c.NewChildAccountClientFactory(nil)
+
+	result, err := factory.MultiClient(ctx, opts)
+	if err != nil {
+		// Revoke the tokens of any child accounts created before the failure
+		if closeErr := factory.Close(ctx); closeErr != nil {
+			return nil, errors.Join(err, closeErr)
+		}
+
+		return nil, err
+	}
+
+	result.close = factory.Close
+
+	return result, nil
+}
+
+// cloneClient creates a copy of this client that can be modified and
+// authenticated independently, e.g. to make requests against a child account.
+func (c *Client) cloneClient() *Client {
+	clone := *c
+
+	// Copy any shared mutable state so the clone can be modified independently
+	clone.header = c.header.Clone()
+	clone.credentialProvider = nil
+	clone.onBeforeRequest = slices.Clone(c.onBeforeRequest)
+	clone.onAfterResponse = slices.Clone(c.onAfterResponse)
+	clone.cacheInvalidationRules = slices.Clone(c.cacheInvalidationRules)
+	clone.configProfiles = maps.Clone(c.configProfiles)
+
+	if c.profileOverrides != nil {
+		overrides := *c.profileOverrides
+		clone.profileOverrides = &overrides
+	}
+	clone.cachedEntries = make(map[string]clientCacheEntry)
+	clone.cachedEntryLock = &sync.RWMutex{}
+	clone.inflightRequests = &inflightGroup{}
+	clone.cacheInvalidations = &cacheInvalidationLog{}
+
+	return &clone
+}
+
+// MultiClientDo runs the given function against each target of the MultiClient concurrently,
+// returning the results of successful targets in target order.
+// If any target fails, a *MultiClientError describing each failure is returned
+// alongside the results of the successful targets.
+//
+// NOTE: fn is called concurrently, so any ListOptions should be created
+// inside fn rather than shared between calls.
+func MultiClientDo[T any](
+	ctx context.Context,
+	mc *MultiClient,
+	fn func(ctx context.Context, client *Client) (T, error),
+) ([]MultiClientItem[T], error) {
+	results := make([]*MultiClientItem[T], len(mc.Targets))
+	errs := make([]error, len(mc.Targets))
+
+	concurrency := mc.Concurrency
+	if concurrency <= 0 {
+		concurrency = len(mc.Targets)
+	}
+
+	semaphore := make(chan struct{}, max(concurrency, 1))
+
+	var wg sync.WaitGroup
+
+	for i, target := range mc.Targets {
+		wg.Go(func() {
+			select {
+			case semaphore <- struct{}{}:
+				defer func() { <-semaphore }()
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+
+			value, err := fn(ctx, target.Client)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+
+			results[i] = &MultiClientItem[T]{Target: target.Name, Item: value}
+		})
+	}
+
+	wg.Wait()
+
+	result := make([]MultiClientItem[T], 0, len(results))
+	failures := make(map[string]error)
+
+	for i, item := range results {
+		if errs[i] != nil {
+			failures[mc.Targets[i].Name] = errs[i]
+			continue
+		}
+
+		result = append(result, *item)
+	}
+
+	if len(failures) > 0 {
+		return result, &MultiClientError{Errors: failures}
+	}
+
+	return result, nil
+}
+
+// MultiClientList runs the given list function against each target of the MultiClient concurrently,
+// returning the combined items of all successful targets tagged with their target.
+// If any target fails, a *MultiClientError describing each failure is returned
+// alongside the items of the successful targets.
+//
+// For example, to list all instances tagged "prod" across all targets:
+//
+//	instances, err := linodego.MultiClientList(ctx, mc, func(ctx context.Context, c *linodego.Client) ([]linodego.Instance, error) {
+//		return c.ListInstances(ctx, linodego.NewListOptions(0, `{"tags": "prod"}`))
+//	})
+func MultiClientList[T any](
+	ctx context.Context,
+	mc *MultiClient,
+	fn func(ctx context.Context, client *Client) ([]T, error),
+) ([]MultiClientItem[T], error) {
+	pages, err := MultiClientDo(ctx, mc, fn)
+
+	result := make([]MultiClientItem[T], 0)
+
+	for _, page := range pages {
+		for _, item := range page.Item {
+			result = append(result, MultiClientItem[T]{Target: page.Target, Item: item})
+		}
+	}
+
+	return result, err
+}
@@ -0,0 +1,155 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiClient_FromConfig(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Header.Get("Authorization") {
+		case "Bearer token-a":
+			_, _ = w.Write([]byte(`{"data": [{"id": 1}, {"id": 2}], "page": 1, "pages": 1, "results": 2}`))
+		case "Bearer token-b":
+			_, _ = w.Write([]byte(`{"data": [{"id": 3}], "page": 1, "pages": 1, "results": 1}`))
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Invalid Token"}]}`))
+		}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	file := createTestConfig(t, fmt.Sprintf(`
+[default]
+api_url = %s
+
+[account-a]
+token = token-a
+
+[account-b]
+token = token-b
+
+[account-c]
+token = token-c
+`, server.URL))
+
+	mc, err := NewMultiClientFromConfig(server.Client(), file.Name())
+	require.NoError(t, err)
+	require.Len(t, mc.Targets, 3)
+
+	// Each target should have its own http.Client so profile settings don't leak between them
+	require.NotSame(t, mc.Targets[0].Client.httpClient, mc.Targets[1].Client.httpClient)
+	require.NotSame(t, mc.Targets[0].Client.httpClient.Transport, mc.Targets[1].Client.httpClient.Transport)
+
+	instances, err := MultiClientList(context.Background(), mc, func(ctx context.Context, c *Client) ([]Instance, error) {
+		return c.ListInstances(ctx, nil)
+	})
+
+	var multiErr *MultiClientError
+	require.True(t, errors.As(err, &multiErr))
+	require.Len(t, multiErr.Errors, 1)
+	require.True(t, ErrHasStatus(multiErr.Errors["account-c"], http.StatusUnauthorized))
+	require.True(t, ErrHasStatus(err, http.StatusUnauthorized))
+
+	require.Equal(t, []MultiClientItem[Instance]{
+		{Target: "account-a", Item: Instance{ID: 1}},
+		{Target: "account-a", Item: Instance{ID: 2}},
+		{Target: "account-b", Item: Instance{ID: 3}},
+	}, instances)
+}
+
+func TestMultiClient_FromChildAccounts(t *testing.T) {
+	var (
+		lock    sync.Mutex
+		revoked []string
+	)
+
+	failChild := ""
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == "/v4/account/child-accounts":
+			_, _ = w.Write([]byte(`{"data": [{"euuid": "child-1"}, {"euuid": "child-2"}], "page": 1, "pages": 1, "results": 2}`))
+		case r.Method == http.MethodPost:
+			euuid := r.URL.Path[len("/v4/account/child-accounts/") : len(r.URL.Path)-len("/token")]
+			if euuid == failChild {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"errors": [{"reason": "Forbidden"}]}`))
+
+				return
+			}
+
+			_, _ = fmt.Fprintf(w, `{"id": %s, "token": "token-%s"}`, euuid[len("child-"):], euuid)
+		case r.Method == http.MethodDelete:
+			lock.Lock()
+			revoked = append(revoked, r.Header.Get("Authorization"))
+			lock.Unlock()
+
+			_, _ = w.Write([]byte(`{}`))
+		case r.URL.Path == "/v4/profile":
+			_, _ = fmt.Fprintf(w, `{"username": "%s"}`, r.Header.Get("Authorization"))
+		default:
+			http.NotFound(w, r)
+		}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	client := newTestClient(t, server.Client())
+	client.SetBaseURL(server.URL)
+	client.SetToken("parent")
+
+	mc, err := client.NewMultiClientFromChildAccounts(context.Background(), nil)
+	require.NoError(t, err)
+
+	profiles, err := MultiClientDo(context.Background(), mc, func(ctx context.Context, c *Client) (*Profile, error) {
+		return c.GetProfile(ctx)
+	})
+	require.NoError(t, err)
+
+	require.Len(t, profiles, 2)
+	require.Equal(t, "child-1", profiles[0].Target)
+	require.Equal(t, "Bearer token-child-1", profiles[0].Item.Username)
+	require.Equal(t, "child-2", profiles[1].Target)
+	require.Equal(t, "Bearer token-child-2", profiles[1].Item.Username)
+
+	// The parent client should not be affected
+	require.Equal(t, "Bearer parent", client.header.Get("Authorization"))
+
+	require.NoError(t, mc.Close(context.Background()))
+	require.Equal(t, []string{"Bearer token-child-1", "Bearer token-child-2"}, revoked)
+
+	// Tokens created before a failure should be revoked
+	revoked = nil
+	failChild = "child-2"
+
+	_, err = client.NewMultiClientFromChildAccounts(context.Background(), nil)
+	require.True(t, ErrHasStatus(err, http.StatusForbidden))
+	require.Equal(t, []string{"Bearer token-child-1"}, revoked)
+}
+
+func TestMultiClient_CloneClientIsIndependent(t *testing.T) {
+	client := newTestClient(t, nil)
+	client.configProfiles["default"] = ConfigProfile{APIToken: "parent"}
+
+	clone := client.cloneClient()
+	clone.AddCacheInvalidationRule("foo/bar", "baz")
+	clone.configProfiles["child"] = ConfigProfile{APIToken: "child"}
+
+	require.Len(t, client.cacheInvalidationRules, len(defaultCacheInvalidationRules))
+	require.NotContains(t, client.configProfiles, "child")
+}
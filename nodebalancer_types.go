@@ -25,21 +25,5 @@ type NodeBalancerTypeRegionPrice struct {
 func (c *Client) ListNodeBalancerTypes(ctx context.Context, opts *ListOptions) ([]NodeBalancerType, error) {
 	e := "nodebalancers/types"
 
-	endpoint, err := generateListCacheURL(e, opts)
-	if err != nil {
-		return nil, err
-	}
-
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]NodeBalancerType), nil
-	}
-
-	response, err := getPaginatedResults[NodeBalancerType](ctx, c, e, opts)
-	if err != nil {
-		return nil, err
-	}
-
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
-
-	return response, nil
+	return getCachedPaginatedResults[NodeBalancerType](ctx, c, e, opts, &cacheExpiryTime)
 }
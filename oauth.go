@@ -0,0 +1,307 @@
+package linodego
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	// OAuthAuthURL is the Linode OAuth authorization endpoint
+	OAuthAuthURL = "https://login.linode.com/oauth/authorize"
+	// OAuthTokenURL is the Linode OAuth token endpoint
+	OAuthTokenURL = "https://login.linode.com/oauth/token"
+
+	// oauthCallbackReadHeaderTimeout is the read header timeout of the local callback server.
+	oauthCallbackReadHeaderTimeout = 10 * time.Second
+
+	// oauthCallbackShutdownTimeout is the maximum time to wait for the local callback server to shut down.
+	oauthCallbackShutdownTimeout = 5 * time.Second
+)
+
+// OAuthEndpoint is the oauth2.Endpoint for the Linode login service.
+var OAuthEndpoint = oauth2.Endpoint{
+	AuthURL:   OAuthAuthURL,
+	TokenURL:  OAuthTokenURL,
+	AuthStyle: oauth2.AuthStyleInParams,
+}
+
+// NewOAuthConfig creates an oauth2.Config for the given Linode OAuth Client.
+// The redirectURL must match the redirect URI configured for the OAuth Client.
+func NewOAuthConfig(clientID, clientSecret, redirectURL string, scopes ...string) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		RedirectURL:  redirectURL,
+		Scopes:       scopes,
+		Endpoint:     OAuthEndpoint,
+	}
+}
+
+// OAuthAuthorizeOptions configures an OAuth authorization-code flow run by AuthorizeOAuth.
+type OAuthAuthorizeOptions struct {
+	// OpenURL is called with the authorization URL the user must visit,
+	// e.g. to print it or open it in a browser.
+	OpenURL func(authURL string) error
+
+	// ListenAddr is the local address to listen for the OAuth callback on.
+	// Defaults to the host of the config's RedirectURL.
+	ListenAddr string
+
+	// DisablePKCE disables the use of a PKCE code challenge.
+	DisablePKCE bool
+}
+
+// AuthorizeOAuth runs the OAuth authorization-code flow for the given config.
+// A local HTTP server is started to receive the callback at the config's RedirectURL,
+// and the received authorization code is exchanged for a token.
+// A PKCE code challenge is used unless disabled in the options.
+func AuthorizeOAuth(ctx context.Context, config *oauth2.Config, opts OAuthAuthorizeOptions) (*oauth2.Token, error) {
+	if opts.OpenURL == nil {
+		return nil, fmt.Errorf("OpenURL must be specified")
+	}
+
+	redirectURL, err := url.Parse(config.RedirectURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse redirect URL: %w", err)
+	}
+
+	listenAddr := opts.ListenAddr
+	if listenAddr == "" {
+		listenAddr = oauthListenAddr(redirectURL)
+	}
+
+	state, err := generateOAuthState()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		authOptions     []oauth2.AuthCodeOption
+		exchangeOptions []oauth2.AuthCodeOption
+	)
+
+	if !opts.DisablePKCE {
+		verifier := oauth2.GenerateVerifier()
+		authOptions = append(authOptions, oauth2.S256ChallengeOption(verifier))
+		exchangeOptions = append(exchangeOptions, oauth2.VerifierOption(verifier))
+	}
+
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", listenAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for OAuth callback on %s: %w", listenAddr, err)
+	}
+
+	codeChan := make(chan string, 1)
+	errChan := make(chan error, 1)
+
+	server := &http.Server{
+		Handler:           oauthCallbackHandler(redirectURL.Path, state, codeChan, errChan),
+		ReadHeaderTimeout: oauthCallbackReadHeaderTimeout,
+	}
+
+	go func() {
+		if serveErr := server.Serve(listener); serveErr != nil && !errors.Is(serveErr, http.ErrServerClosed) {
+			errChan <- fmt.Errorf("failed to serve OAuth callback: %w", serveErr)
+		}
+	}()
+
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), oauthCallbackShutdownTimeout)
+		defer cancel()
+
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	if err := opts.OpenURL(config.AuthCodeURL(state, authOptions...)); err != nil {
+		return nil, fmt.Errorf("failed to open authorization URL: %w", err)
+	}
+
+	select {
+	case code := <-codeChan:
+		token, err := config.Exchange(ctx, code, exchangeOptions...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to exchange authorization code: %w", err)
+		}
+
+		return token, nil
+	case err := <-errChan:
+		return nil, err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("failed to wait for OAuth callback: %w", ctx.Err())
+	}
+}
+
+// oauthListenAddr returns the local address to listen for callbacks to the given redirect URL on.
+// Redirect URLs without a port use the default HTTP port.
+func oauthListenAddr(redirectURL *url.URL) string {
+	port := redirectURL.Port()
+	if port == "" {
+		port = "80"
+	}
+
+	return net.JoinHostPort(redirectURL.Hostname(), port)
+}
+
+func oauthCallbackHandler(path, state string, codeChan chan<- string, errChan chan<- error) http.Handler {
+	if path == "" {
+		path = "/"
+	}
+
+	var once sync.Once
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			http.NotFound(w, r)
+			return
+		}
+
+		query := r.URL.Query()
+
+		if query.Get("state") != state {
+			http.Error(w, "Invalid OAuth state.", http.StatusBadRequest)
+			return
+		}
+
+		once.Do(func() {
+			if oauthErr := query.Get("error"); oauthErr != "" {
+				http.Error(w, "Authorization failed.", http.StatusBadRequest)
+				errChan <- fmt.Errorf("authorization failed: %s %s", oauthErr, query.Get("error_description"))
+
+				return
+			}
+
+			code := query.Get("code")
+			if code == "" {
+				http.Error(w, "Missing authorization code.", http.StatusBadRequest)
+				errChan <- fmt.Errorf("authorization callback did not contain a code")
+
+				return
+			}
+
+			_, _ = w.Write([]byte("Authorization complete, you may close this window."))
+			codeChan <- code
+		})
+	})
+}
+
+func generateOAuthState() (string, error) {
+	const stateLength = 32
+
+	buf := make([]byte, stateLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate OAuth state: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// OAuthTokenSource is an oauth2.TokenSource that transparently refreshes
+// its token using the token's refresh token. Unlike the token sources provided
+// by the oauth2 package, it can be forced to refresh using Invalidate().
+type OAuthTokenSource struct {
+	ctx       context.Context
+	config    *oauth2.Config
+	onRefresh func(*oauth2.Token)
+
+	lock  sync.Mutex
+	token *oauth2.Token
+}
+
+// NewOAuthTokenSource creates a new OAuthTokenSource for the given config and token.
+// If onRefresh is not nil, it is called with each refreshed token so that it can be
+// persisted, e.g. to store a rotated refresh token.
+func NewOAuthTokenSource(
+	ctx context.Context,
+	config *oauth2.Config,
+	token *oauth2.Token,
+	onRefresh func(*oauth2.Token),
+) *OAuthTokenSource {
+	return &OAuthTokenSource{
+		ctx:       ctx,
+		config:    config,
+		token:     token,
+		onRefresh: onRefresh,
+	}
+}
+
+// Token implements oauth2.TokenSource.
+func (s *OAuthTokenSource) Token() (*oauth2.Token, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.token.Valid() {
+		return s.token, nil
+	}
+
+	if s.token == nil || s.token.RefreshToken == "" {
+		return nil, fmt.Errorf("token has expired and cannot be refreshed")
+	}
+
+	token, err := s.config.TokenSource(s.ctx, &oauth2.Token{RefreshToken: s.token.RefreshToken}).Token()
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh token: %w", err)
+	}
+
+	s.token = token
+
+	if s.onRefresh != nil {
+		s.onRefresh(token)
+	}
+
+	return token, nil
+}
+
+// Invalidate implements CredentialInvalidator, forcing the token to be refreshed on next use.
+func (s *OAuthTokenSource) Invalidate() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.token == nil {
+		return
+	}
+
+	invalidated := *s.token
+	invalidated.AccessToken = ""
+	s.token = &invalidated
+}
+
+// TokenSourceCredentialProvider is a CredentialProvider which resolves
+// credentials from an oauth2.TokenSource.
+type TokenSourceCredentialProvider struct {
+	Source oauth2.TokenSource
+}
+
+// Retrieve implements CredentialProvider.
+func (p TokenSourceCredentialProvider) Retrieve(_ context.Context) (*Credentials, error) {
+	token, err := p.Source.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Credentials{Token: token.AccessToken, Expiry: token.Expiry, Source: "oauth2"}, nil
+}
+
+// Invalidate implements CredentialInvalidator if the underlying token source supports it.
+func (p TokenSourceCredentialProvider) Invalidate() {
+	if invalidator, ok := p.Source.(CredentialInvalidator); ok {
+		invalidator.Invalidate()
+	}
+}
+
+// SetTokenSource configures the client to authenticate each request using
+// a token from the given oauth2.TokenSource.
+// Tokens are refreshed transparently by the token source; if the token source is
+// an *OAuthTokenSource, rejected tokens are also refreshed and the request retried.
+func (c *Client) SetTokenSource(source oauth2.TokenSource) *Client {
+	return c.SetCredentialProvider(TokenSourceCredentialProvider{Source: source})
+}
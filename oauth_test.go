@@ -0,0 +1,177 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+// newTestOAuthServer creates a stand-in Linode OAuth server supporting
+// the authorization-code (with PKCE) and refresh token grants.
+func newTestOAuthServer(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	const code = "test-code"
+
+	var (
+		challenge     string
+		refreshCount  atomic.Int32
+		mux           = http.NewServeMux()
+		writeTokenFor = func(w http.ResponseWriter, accessToken string) {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"access_token":  accessToken,
+				"refresh_token": "refresh-" + accessToken,
+				"token_type":    "bearer",
+				"expires_in":    7200,
+			})
+		}
+	)
+
+	mux.HandleFunc("/oauth/authorize", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		challenge = query.Get("code_challenge")
+
+		redirect, err := url.Parse(query.Get("redirect_uri"))
+		require.NoError(t, err)
+
+		redirect.RawQuery = url.Values{"code": {code}, "state": {query.Get("state")}}.Encode()
+
+		http.Redirect(w, r, redirect.String(), http.StatusFound)
+	})
+
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "test-client", r.Form.Get("client_id"))
+
+		switch r.Form.Get("grant_type") {
+		case "authorization_code":
+			if r.Form.Get("code") != code || oauth2.S256ChallengeFromVerifier(r.Form.Get("code_verifier")) != challenge {
+				http.Error(w, `{"error": "invalid_grant"}`, http.StatusBadRequest)
+				return
+			}
+
+			writeTokenFor(w, "initial")
+		case "refresh_token":
+			count := refreshCount.Add(1)
+			writeTokenFor(w, fmt.Sprintf("refreshed-%d", count))
+		default:
+			http.Error(w, `{"error": "unsupported_grant_type"}`, http.StatusBadRequest)
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server, &refreshCount
+}
+
+func newTestOAuthConfig(t *testing.T, server *httptest.Server, redirectURL string) *oauth2.Config {
+	t.Helper()
+
+	config := NewOAuthConfig("test-client", "test-secret", redirectURL, "linodes:read_only")
+	config.Endpoint.AuthURL = server.URL + "/oauth/authorize"
+	config.Endpoint.TokenURL = server.URL + "/oauth/token"
+
+	return config
+}
+
+func TestOAuth_AuthorizeOAuth(t *testing.T) {
+	server, _ := newTestOAuthServer(t)
+
+	// Reserve a free local port for the callback listener
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	require.NoError(t, listener.Close())
+
+	config := newTestOAuthConfig(t, server, fmt.Sprintf("http://%s/callback", listener.Addr()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	token, err := AuthorizeOAuth(ctx, config, OAuthAuthorizeOptions{
+		// Simulate the user visiting the authorization URL in a browser
+		OpenURL: func(authURL string) error {
+			go func() {
+				resp, err := server.Client().Get(authURL)
+				if err == nil {
+					resp.Body.Close()
+				}
+			}()
+
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "initial", token.AccessToken)
+	require.Equal(t, "refresh-initial", token.RefreshToken)
+}
+
+func TestOAuth_ListenAddr(t *testing.T) {
+	for redirectURL, expected := range map[string]string{
+		"http://localhost/callback":      "localhost:80",
+		"http://localhost:8080/callback": "localhost:8080",
+		"http://[::1]/callback":          "[::1]:80",
+		"http://[::1]:8080/callback":     "[::1]:8080",
+	} {
+		parsed, err := url.Parse(redirectURL)
+		require.NoError(t, err)
+		require.Equal(t, expected, oauthListenAddr(parsed), redirectURL)
+	}
+}
+
+func TestOAuth_TokenSourceRefresh(t *testing.T) {
+	oauthServer, refreshCount := newTestOAuthServer(t)
+
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		// Reject the expired token to force a refresh
+		if r.Header.Get("Authorization") == "Bearer refreshed-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Invalid Token"}]}`))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"message": "success"}`))
+	}))
+	defer apiServer.Close()
+
+	config := newTestOAuthConfig(t, oauthServer, "http://localhost/callback")
+
+	var persisted []string
+
+	source := NewOAuthTokenSource(
+		context.Background(),
+		config,
+		&oauth2.Token{AccessToken: "expired", RefreshToken: "refresh-expired", Expiry: time.Now().Add(-time.Hour)},
+		func(token *oauth2.Token) {
+			persisted = append(persisted, token.RefreshToken)
+		},
+	)
+
+	client := newTestClient(t, apiServer.Client())
+	client.SetBaseURL(apiServer.URL)
+	client.SetTokenSource(source)
+
+	require.NoError(t, client.doRequest(context.Background(), http.MethodGet, "/foo", requestParams{}, nil))
+
+	// The expired token is refreshed, rejected by the API, then refreshed again
+	require.EqualValues(t, 2, refreshCount.Load())
+	require.Equal(t, []string{"refresh-refreshed-1", "refresh-refreshed-2"}, persisted)
+
+	token, err := source.Token()
+	require.NoError(t, err)
+	require.Equal(t, "refreshed-2", token.AccessToken)
+}
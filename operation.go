@@ -0,0 +1,274 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// Operation is a handle to an asynchronous operation triggered by a mutation,
+// e.g. booting an Instance.
+//
+// Operations are created by the *Operation variants of mutation functions, which
+// record the entity's existing events before the mutation is made so that the event
+// triggered by the mutation can be identified without racing against it.
+type Operation struct {
+	client  Client
+	refresh func(ctx context.Context) (*Event, EventStatus, int, error)
+
+	lock     sync.Mutex
+	event    *Event
+	status   EventStatus
+	progress int
+}
+
+// Status returns the last observed status of the operation.
+// The status is EventScheduled until the operation has been observed to start.
+func (o *Operation) Status() EventStatus {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	return o.status
+}
+
+// Progress returns the last observed completion percentage of the operation,
+// as reported by the percent_complete field of the triggering event.
+func (o *Operation) Progress() int {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	return o.progress
+}
+
+// Event returns the last observed state of the event triggered by the operation,
+// or nil if the event has not yet been observed or the operation is not tracked using events.
+func (o *Operation) Event() *Event {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	return o.event
+}
+
+// Refresh polls the current status and progress of the operation once.
+func (o *Operation) Refresh(ctx context.Context) error {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	event, status, progress, err := o.refresh(ctx)
+	if err != nil {
+		return err
+	}
+
+	if event != nil {
+		o.event = event
+	}
+
+	o.status = status
+	o.progress = progress
+
+	return nil
+}
+
+// Wait waits for the operation to finish, returning an error if the operation fails.
+func (o *Operation) Wait(ctx context.Context) error {
+	_, err := waitFor(ctx, &o.client,
+		func(ctx context.Context) (EventStatus, error) {
+			if err := o.Refresh(ctx); err != nil {
+				return "", err
+			}
+
+			return o.Status(), nil
+		},
+		func(status EventStatus) (bool, error) {
+			if status != EventFailed {
+				return status == EventFinished, nil
+			}
+
+			if event := o.Event(); event != nil {
+				return false, fmt.Errorf("event %d has failed", event.ID)
+			}
+
+			return false, fmt.Errorf("operation has failed")
+		},
+		nil,
+		func(err error) error {
+			return fmt.Errorf("failed to wait for operation: %w", err)
+		},
+	)
+
+	return err
+}
+
+// newEventOperation creates an Operation tracking the next event not observed by the given poller.
+func newEventOperation(poller *EventPoller) *Operation {
+	result := &Operation{
+		client: poller.client,
+		status: EventScheduled,
+	}
+
+	result.refresh = func(ctx context.Context) (*Event, EventStatus, int, error) {
+		var (
+			event *Event
+			err   error
+		)
+
+		if result.event == nil {
+			event, err = poller.nextUnknownEvent(ctx)
+			if err != nil {
+				return nil, "", 0, err
+			}
+
+			if event == nil {
+				// The triggering event has not been created yet
+				return nil, EventScheduled, 0, nil
+			}
+		} else {
+			event, err = poller.client.GetEvent(ctx, result.event.ID)
+			if err != nil {
+				return nil, "", 0, fmt.Errorf("failed to get event: %w", err)
+			}
+		}
+
+		progress := event.PercentComplete
+		if event.Status == EventFinished {
+			progress = 100
+		}
+
+		return event, event.Status, progress, nil
+	}
+
+	return result
+}
+
+// startEventOperation runs the given mutation, returning an Operation tracking the event it triggers.
+func startEventOperation(poller *EventPoller, mutate func() error) (*Operation, error) {
+	if err := mutate(); err != nil {
+		return nil, err
+	}
+
+	return newEventOperation(poller), nil
+}
+
+// BootInstanceOperation boots a Linode instance, returning an Operation tracking the boot.
+func (c *Client) BootInstanceOperation(ctx context.Context, linodeID int, opts InstanceBootOptions) (*Operation, error) {
+	poller, err := c.NewEventPoller(ctx, linodeID, EntityLinode, ActionLinodeBoot)
+	if err != nil {
+		return nil, err
+	}
+
+	return startEventOperation(poller, func() error {
+		return c.BootInstance(ctx, linodeID, opts)
+	})
+}
+
+// ResizeInstanceOperation resizes an instance to a new Linode type,
+// returning an Operation tracking the resize.
+func (c *Client) ResizeInstanceOperation(ctx context.Context, linodeID int, opts InstanceResizeOptions) (*Operation, error) {
+	poller, err := c.NewEventPoller(ctx, linodeID, EntityLinode, ActionLinodeResize)
+	if err != nil {
+		return nil, err
+	}
+
+	return startEventOperation(poller, func() error {
+		return c.ResizeInstance(ctx, linodeID, opts)
+	})
+}
+
+// MigrateInstanceOperation migrates a Linode instance, returning an Operation tracking the migration.
+func (c *Client) MigrateInstanceOperation(ctx context.Context, linodeID int, opts InstanceMigrateOptions) (*Operation, error) {
+	action := ActionLinodeMigrate
+	if opts.Region != "" {
+		action = ActionLinodeMigrateDatacenter
+	}
+
+	poller, err := c.NewEventPoller(ctx, linodeID, EntityLinode, action)
+	if err != nil {
+		return nil, err
+	}
+
+	return startEventOperation(poller, func() error {
+		return c.MigrateInstance(ctx, linodeID, opts)
+	})
+}
+
+// CloneVolumeOperation clones a Linode volume, returning the new Volume
+// and an Operation tracking the clone.
+func (c *Client) CloneVolumeOperation(ctx context.Context, volumeID int, opts VolumeCloneOptions) (*Volume, *Operation, error) {
+	poller, err := c.NewEventPoller(ctx, volumeID, EntityVolume, ActionVolumeClone)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var volume *Volume
+
+	operation, err := startEventOperation(poller, func() error {
+		var cloneErr error
+
+		volume, cloneErr = c.CloneVolume(ctx, volumeID, opts)
+
+		return cloneErr
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return volume, operation, nil
+}
+
+// RecycleLKENodePoolOperation recycles the LKENodePool with the specified id,
+// returning an Operation tracking the recycle.
+func (c *Client) RecycleLKENodePoolOperation(ctx context.Context, clusterID, poolID int) (*Operation, error) {
+	poller, err := c.NewEventPoller(ctx, poolID, EntityLKENodePool, ActionLKEPoolRecycle)
+	if err != nil {
+		return nil, err
+	}
+
+	return startEventOperation(poller, func() error {
+		return c.RecycleLKENodePool(ctx, clusterID, poolID)
+	})
+}
+
+// ReplicateImageOperation replicates an image to the given regions, returning the Image
+// and an Operation tracking the replication.
+// Image replication does not trigger an event, so the operation is tracked using the status
+// of the Image's replicas and its progress is the percentage of replicas that are available.
+func (c *Client) ReplicateImageOperation(
+	ctx context.Context,
+	imageID string,
+	opts ImageReplicateOptions,
+) (*Image, *Operation, error) {
+	image, err := c.ReplicateImage(ctx, imageID, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	operation := &Operation{
+		client: *c,
+		status: EventScheduled,
+		refresh: func(ctx context.Context) (*Event, EventStatus, int, error) {
+			current, getErr := c.GetImage(ctx, imageID)
+			if getErr != nil {
+				return nil, "", 0, getErr
+			}
+
+			available := 0
+
+			for _, region := range opts.Regions {
+				if slices.ContainsFunc(current.Regions, func(r ImageRegion) bool {
+					return r.Region == region && r.Status == ImageRegionStatusAvailable
+				}) {
+					available++
+				}
+			}
+
+			if available == len(opts.Regions) {
+				return nil, EventFinished, 100, nil
+			}
+
+			return nil, EventStarted, available * 100 / len(opts.Regions), nil
+		},
+	}
+
+	return image, operation, nil
+}
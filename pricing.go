@@ -0,0 +1,315 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// CostEstimate is an estimated cost in USD.
+type CostEstimate struct {
+	Hourly  float64 `json:"hourly"`
+	Monthly float64 `json:"monthly"`
+}
+
+// Add returns the sum of the estimate and the given estimate.
+func (e CostEstimate) Add(other CostEstimate) CostEstimate {
+	return CostEstimate{Hourly: e.Hourly + other.Hourly, Monthly: e.Monthly + other.Monthly}
+}
+
+// Sub returns the difference between the estimate and the given estimate.
+func (e CostEstimate) Sub(other CostEstimate) CostEstimate {
+	return CostEstimate{Hourly: e.Hourly - other.Hourly, Monthly: e.Monthly - other.Monthly}
+}
+
+// Scale returns the estimate multiplied by the given factor, e.g. a quantity.
+func (e CostEstimate) Scale(factor float64) CostEstimate {
+	return CostEstimate{Hourly: e.Hourly * factor, Monthly: e.Monthly * factor}
+}
+
+func newCostEstimate[F float32 | float64](hourly, monthly F) CostEstimate {
+	return CostEstimate{Hourly: float64(hourly), Monthly: float64(monthly)}
+}
+
+// unpricedError is returned by the priceCatalog when the API has no price for a resource,
+// e.g. because its type has been retired.
+type unpricedError struct {
+	reason string
+}
+
+func (e *unpricedError) Error() string {
+	return e.reason
+}
+
+func newUnpricedError(format string, args ...any) error {
+	return &unpricedError{reason: fmt.Sprintf(format, args...)}
+}
+
+// unpricedReason returns the reason of an unpricedError, or the error itself otherwise.
+func unpricedReason(err error) (string, error) {
+	var unpriced *unpricedError
+	if errors.As(err, &unpriced) {
+		return unpriced.reason, nil
+	}
+
+	return "", err
+}
+
+// priceCatalog estimates the prices of resources using the API's type endpoints,
+// preferring region-specific prices over base prices. Types are only listed once needed.
+type priceCatalog struct {
+	client *Client
+
+	lock          sync.Mutex
+	linodeTypes   []LinodeType
+	volumeTypes   []VolumeType
+	nbTypes       []NodeBalancerType
+	reservedTypes []ReservedIPType
+	lkeTypes      []LKEType
+	databaseTypes []DatabaseType
+	transferTypes []NetworkTransferPrice
+}
+
+func newPriceCatalog(client *Client) *priceCatalog {
+	return &priceCatalog{client: client}
+}
+
+// regionPrice returns the region ID and price of a region-specific price.
+func (p baseTypeRegionPrice) regionPrice() (string, baseTypePrice) {
+	return p.ID, p.baseTypePrice
+}
+
+type regionPricer interface {
+	regionPrice() (string, baseTypePrice)
+}
+
+// regionCost returns the price for the given region if it has a region-specific price,
+// or the given base price otherwise.
+func regionCost[R regionPricer](
+	base baseTypePrice,
+	regionPrices []R,
+	region string,
+) CostEstimate {
+	for _, price := range regionPrices {
+		if id, regional := price.regionPrice(); id == region {
+			return newCostEstimate(regional.Hourly, regional.Monthly)
+		}
+	}
+
+	return newCostEstimate(base.Hourly, base.Monthly)
+}
+
+// findPriceType returns the type with the given ID, falling back to the first type for
+// endpoints which only return a single type, e.g. volumes.
+func findPriceType[T any](types []T, id func(T) string, wanted string) (T, bool) {
+	for _, t := range types {
+		if id(t) == wanted {
+			return t, true
+		}
+	}
+
+	var zero T
+
+	if len(types) == 0 {
+		return zero, false
+	}
+
+	return types[0], wanted == ""
+}
+
+// loadPriceTypes lists the types using the given function unless they have already been listed.
+func loadPriceTypes[T any](
+	ctx context.Context,
+	p *priceCatalog,
+	cached *[]T,
+	list func(c *Client, ctx context.Context, opts *ListOptions) ([]T, error),
+) ([]T, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if *cached != nil {
+		return *cached, nil
+	}
+
+	types, err := list(p.client, ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	*cached = types
+
+	return types, nil
+}
+
+// instance returns the price of an instance of the given type in the given region,
+// including the backups add-on if enabled.
+func (p *priceCatalog) instance(ctx context.Context, typeID, region string, backups bool) (CostEstimate, error) {
+	types, err := loadPriceTypes(ctx, p, &p.linodeTypes, (*Client).ListTypes)
+	if err != nil {
+		return CostEstimate{}, fmt.Errorf("failed to list instance types: %w", err)
+	}
+
+	linodeType, ok := findPriceType(types, func(t LinodeType) string { return t.ID }, typeID)
+	if !ok {
+		return CostEstimate{}, newUnpricedError("unknown instance type %s", typeID)
+	}
+
+	result := linodeCost(linodeType.Price, linodeType.RegionPrices, region)
+
+	if backups && linodeType.Addons != nil && linodeType.Addons.Backups != nil {
+		result = result.Add(linodeCost(linodeType.Addons.Backups.Price, linodeType.Addons.Backups.RegionPrices, region))
+	}
+
+	return result, nil
+}
+
+func linodeCost(price *LinodePrice, regionPrices []LinodeRegionPrice, region string) CostEstimate {
+	for _, regionPrice := range regionPrices {
+		if regionPrice.ID == region {
+			return newCostEstimate(regionPrice.Hourly, regionPrice.Monthly)
+		}
+	}
+
+	if price == nil {
+		return CostEstimate{}
+	}
+
+	return newCostEstimate(price.Hourly, price.Monthly)
+}
+
+// volume returns the price of a volume of the given size in GB in the given region.
+func (p *priceCatalog) volume(ctx context.Context, region string, size int) (CostEstimate, error) {
+	types, err := loadPriceTypes(ctx, p, &p.volumeTypes, (*Client).ListVolumeTypes)
+	if err != nil {
+		return CostEstimate{}, fmt.Errorf("failed to list volume types: %w", err)
+	}
+
+	volumeType, ok := findPriceType(types, func(t VolumeType) string { return t.ID }, "")
+	if !ok {
+		return CostEstimate{}, nil
+	}
+
+	// Volumes are priced per GB
+	return regionCost(volumeType.Price.baseTypePrice, volumeType.RegionPrices, region).Scale(float64(size)), nil
+}
+
+// nodeBalancerStandardTypeID is the ID of the standard NodeBalancer type returned by ListNodeBalancerTypes(...).
+const nodeBalancerStandardTypeID = "nodebalancer"
+
+// nodeBalancer returns the price of a NodeBalancer of the given plan type in the given region.
+func (p *priceCatalog) nodeBalancer(ctx context.Context, planType NodeBalancerPlanType, region string) (CostEstimate, error) {
+	types, err := loadPriceTypes(ctx, p, &p.nbTypes, (*Client).ListNodeBalancerTypes)
+	if err != nil {
+		return CostEstimate{}, fmt.Errorf("failed to list NodeBalancer types: %w", err)
+	}
+
+	typeID := string(planType)
+	if planType == "" || planType == NBTypeCommon {
+		typeID = nodeBalancerStandardTypeID
+	}
+
+	nbType, ok := findPriceType(types, func(t NodeBalancerType) string { return t.ID }, typeID)
+	if !ok {
+		// Fall back to the standard NodeBalancer price for unknown plan types
+		nbType, ok = findPriceType(types, func(t NodeBalancerType) string { return t.ID }, nodeBalancerStandardTypeID)
+		if !ok {
+			return CostEstimate{}, nil
+		}
+	}
+
+	return regionCost(nbType.Price.baseTypePrice, nbType.RegionPrices, region), nil
+}
+
+// reservedIP returns the price of a reserved IP address in the given region.
+func (p *priceCatalog) reservedIP(ctx context.Context, region string) (CostEstimate, error) {
+	types, err := loadPriceTypes(ctx, p, &p.reservedTypes, (*Client).ListReservedIPTypes)
+	if err != nil {
+		return CostEstimate{}, fmt.Errorf("failed to list reserved IP types: %w", err)
+	}
+
+	ipType, ok := findPriceType(types, func(t ReservedIPType) string { return t.ID }, "")
+	if !ok {
+		return CostEstimate{}, nil
+	}
+
+	return regionCost(ipType.Price, ipType.RegionPrices, region), nil
+}
+
+// lkeControlPlane returns the price of an LKE cluster's control plane in the given region.
+// Standard control planes without high availability are free unless the API prices them.
+func (p *priceCatalog) lkeControlPlane(ctx context.Context, region, tier string, highAvailability bool) (CostEstimate, error) {
+	types, err := loadPriceTypes(ctx, p, &p.lkeTypes, (*Client).ListLKETypes)
+	if err != nil {
+		return CostEstimate{}, fmt.Errorf("failed to list LKE types: %w", err)
+	}
+
+	typeID := "lke-sa"
+
+	switch {
+	case tier == "enterprise":
+		typeID = "lke-e"
+	case highAvailability:
+		typeID = "lke-ha"
+	}
+
+	for _, lkeType := range types {
+		if lkeType.ID == typeID {
+			return regionCost(lkeType.Price.baseTypePrice, lkeType.RegionPrices, region), nil
+		}
+	}
+
+	return CostEstimate{}, nil
+}
+
+// database returns the price of a database cluster of the given engine, type and size.
+func (p *priceCatalog) database(ctx context.Context, engine, typeID string, clusterSize int) (CostEstimate, error) {
+	types, err := loadPriceTypes(ctx, p, &p.databaseTypes, (*Client).ListDatabaseTypes)
+	if err != nil {
+		return CostEstimate{}, fmt.Errorf("failed to list database types: %w", err)
+	}
+
+	databaseType, ok := findPriceType(types, func(t DatabaseType) string { return t.ID }, typeID)
+	if !ok {
+		return CostEstimate{}, newUnpricedError("unknown database type %s", typeID)
+	}
+
+	var engines []DatabaseTypeEngine
+
+	switch engine {
+	case "mysql":
+		engines = databaseType.Engines.MySQL
+	case "postgresql":
+		engines = databaseType.Engines.PostgreSQL
+	default:
+		return CostEstimate{}, newUnpricedError("unknown database engine %s", engine)
+	}
+
+	if clusterSize <= 0 {
+		clusterSize = 1
+	}
+
+	for _, size := range engines {
+		if size.Quantity == clusterSize {
+			return newCostEstimate(size.Price.Hourly, size.Price.Monthly), nil
+		}
+	}
+
+	return CostEstimate{}, newUnpricedError("database type %s has no price for %s clusters of %d nodes", typeID, engine, clusterSize)
+}
+
+// networkTransfer returns the monthly price of the given amount of billable network transfer in GB.
+// Transfer overages are billed per GB rather than hourly, so the hourly estimate is zero.
+func (p *priceCatalog) networkTransfer(ctx context.Context, billable int) (CostEstimate, error) {
+	types, err := loadPriceTypes(ctx, p, &p.transferTypes, (*Client).ListNetworkTransferPrices)
+	if err != nil {
+		return CostEstimate{}, fmt.Errorf("failed to list network transfer prices: %w", err)
+	}
+
+	transferType, ok := findPriceType(types, func(t NetworkTransferPrice) string { return t.ID }, "network_transfer")
+	if !ok {
+		return CostEstimate{}, nil
+	}
+
+	return CostEstimate{Monthly: transferType.Price.Monthly * float64(billable)}, nil
+}
@@ -104,40 +104,12 @@ type RegionPlacementGroupLimits struct {
 
 // ListRegions lists Regions. This endpoint is cached by default.
 func (c *Client) ListRegions(ctx context.Context, opts *ListOptions) ([]Region, error) {
-	endpoint, err := generateListCacheURL("regions", opts)
-	if err != nil {
-		return nil, err
-	}
-
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]Region), nil
-	}
-
-	response, err := getPaginatedResults[Region](ctx, c, "regions", opts)
-	if err != nil {
-		return nil, err
-	}
-
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
-
-	return response, nil
+	return getCachedPaginatedResults[Region](ctx, c, "regions", opts, &cacheExpiryTime)
 }
 
 // GetRegion gets the template with the provided ID. This endpoint is cached by default.
 func (c *Client) GetRegion(ctx context.Context, regionID string) (*Region, error) {
 	e := formatAPIPath("regions/%s", regionID)
 
-	if result := c.getCachedResponse(e); result != nil {
-		result := result.(Region)
-		return &result, nil
-	}
-
-	response, err := doGETRequest[Region](ctx, c, e)
-	if err != nil {
-		return nil, err
-	}
-
-	c.addCachedResponse(e, response, &cacheExpiryTime)
-
-	return response, nil
+	return doCachedGETRequest[Region](ctx, c, e, &cacheExpiryTime)
 }
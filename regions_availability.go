@@ -22,40 +22,18 @@ type RegionVPCAvailability struct {
 func (c *Client) ListRegionsAvailability(ctx context.Context, opts *ListOptions) ([]RegionAvailability, error) {
 	e := "regions/availability"
 
-	endpoint, err := generateListCacheURL(e, opts)
-	if err != nil {
-		return nil, err
-	}
-
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]RegionAvailability), nil
-	}
-
-	response, err := getPaginatedResults[RegionAvailability](ctx, c, e, opts)
-	if err != nil {
-		return nil, err
-	}
-
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
-
-	return response, nil
+	return getCachedPaginatedResults[RegionAvailability](ctx, c, e, opts, &cacheExpiryTime)
 }
 
 // GetRegionAvailability gets availability for all plans in the provided region. This endpoint is cached by default.
 func (c *Client) GetRegionAvailability(ctx context.Context, regionID string) ([]RegionAvailability, error) {
 	e := formatAPIPath("regions/%s/availability", regionID)
 
-	if result := c.getCachedResponse(e); result != nil {
-		return result.([]RegionAvailability), nil
-	}
-
-	response, err := doGETRequest[[]RegionAvailability](ctx, c, e)
+	response, err := doCachedGETRequest[[]RegionAvailability](ctx, c, e, &cacheExpiryTime)
 	if err != nil {
 		return nil, err
 	}
 
-	c.addCachedResponse(e, *response, &cacheExpiryTime)
-
 	return *response, nil
 }
 
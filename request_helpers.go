@@ -5,9 +5,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"iter"
 	"net/http"
 	"net/url"
 	"reflect"
+	"time"
 )
 
 // PaginatedResponse represents a single response from a paginated
@@ -19,9 +22,107 @@ type PaginatedResponse[T any] struct {
 	Data    []T `json:"data"`
 }
 
+// decodePaginatedResponse decodes a paginated response from the given reader,
+// decoding each entry in the "data" array into the location returned by next.
+// The page metadata is written into meta; meta.Data is left untouched.
+func decodePaginatedResponse[T any](
+	r io.Reader,
+	meta *PaginatedResponse[T],
+	next func() *T,
+) error {
+	decoder := json.NewDecoder(r)
+
+	if err := expectJSONDelim(decoder, '{'); err != nil {
+		return err
+	}
+
+	for decoder.More() {
+		keyToken, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		key, ok := keyToken.(string)
+		if !ok {
+			return fmt.Errorf("expected object key, got %v", keyToken)
+		}
+
+		switch key {
+		case "page":
+			err = decoder.Decode(&meta.Page)
+		case "pages":
+			err = decoder.Decode(&meta.Pages)
+		case "results":
+			err = decoder.Decode(&meta.Results)
+		case "data":
+			err = decodeJSONArray(decoder, next)
+		default:
+			// Skip unknown fields
+			err = decoder.Decode(&json.RawMessage{})
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to decode field %q: %w", key, err)
+		}
+	}
+
+	return expectJSONDelim(decoder, '}')
+}
+
+// decodeJSONArray decodes each element of the next JSON array in the given decoder
+// into the location returned by next. Decoding directly into the caller's storage
+// avoids allocating each element separately. A null array is treated as empty.
+func decodeJSONArray[T any](decoder *json.Decoder, next func() *T) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	if token == nil {
+		return nil
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected JSON array, got %v", token)
+	}
+
+	for decoder.More() {
+		if err = decoder.Decode(next()); err != nil {
+			return err
+		}
+	}
+
+	return expectJSONDelim(decoder, ']')
+}
+
+func expectJSONDelim(decoder *json.Decoder, expected json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != expected {
+		return fmt.Errorf("expected %q, got %v", expected, token)
+	}
+
+	return nil
+}
+
+// paginatedSink receives the entries of a paginated endpoint as they are decoded.
+type paginatedSink[T any] struct {
+	// begin is called before each attempt to decode a page, so that entries decoded
+	// by a previously failed attempt can be discarded.
+	begin func()
+
+	// next returns the location to decode the next entry into.
+	next func() *T
+
+	// end is called once a page has been decoded, returning false to stop requesting pages.
+	end func() bool
+}
+
 // handlePaginatedResults aggregates results from the given
 // paginated endpoint using the provided ListOptions and HTTP method.
-// nolint:funlen
 func handlePaginatedResults[T any, O any](
 	ctx context.Context,
 	client *Client,
@@ -31,7 +132,86 @@ func handlePaginatedResults[T any, O any](
 	options ...O,
 ) ([]T, error) {
 	result := make([]T, 0)
+	pageStart := 0
+
+	err := walkPaginatedResults(ctx, client, endpoint, opts, method, paginatedSink[T]{
+		begin: func() {
+			result = result[:pageStart]
+		},
+		// Decode entries directly into the result rather than
+		// decoding each page into an intermediate slice.
+		next: func() *T {
+			var entry T
+
+			result = append(result, entry)
+
+			return &result[len(result)-1]
+		},
+		end: func() bool {
+			pageStart = len(result)
+			return true
+		},
+	}, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// iteratePaginatedResults returns an iterator over the entries of the given paginated
+// endpoint. Pages are requested as the previous page is consumed, so only a single page
+// is held in memory at a time. Iteration stops after yielding the first error.
+func iteratePaginatedResults[T any](
+	ctx context.Context,
+	client *Client,
+	endpoint string,
+	opts *ListOptions,
+) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		page := make([]T, 0)
+
+		err := walkPaginatedResults[T, any](ctx, client, endpoint, opts, http.MethodGet, paginatedSink[T]{
+			begin: func() {
+				page = page[:0]
+			},
+			next: func() *T {
+				var entry T
+
+				page = append(page, entry)
+
+				return &page[len(page)-1]
+			},
+			end: func() bool {
+				for _, entry := range page {
+					if !yield(entry, nil) {
+						return false
+					}
+				}
+
+				return true
+			},
+		})
+		if err != nil {
+			var zero T
 
+			yield(zero, err)
+		}
+	}
+}
+
+// walkPaginatedResults requests the pages of the given paginated endpoint using
+// the provided ListOptions and HTTP method, streaming their entries into sink.
+// nolint:funlen
+func walkPaginatedResults[T any, O any](
+	ctx context.Context,
+	client *Client,
+	endpoint string,
+	opts *ListOptions,
+	method string,
+	sink paginatedSink[T],
+	options ...O,
+) error {
 	if opts == nil {
 		opts = &ListOptions{PageOptions: &PageOptions{Page: 0}}
 	}
@@ -43,7 +223,7 @@ func handlePaginatedResults[T any, O any](
 	// Validate options
 	numOpts := len(options)
 	if numOpts > 1 {
-		return nil, fmt.Errorf("invalid number of options: expected 0 or 1, got %d", numOpts)
+		return fmt.Errorf("invalid number of options: expected 0 or 1, got %d", numOpts)
 	}
 
 	// Prepare request body if options are provided
@@ -52,21 +232,24 @@ func handlePaginatedResults[T any, O any](
 	if numOpts > 0 && !isNil(options[0]) {
 		body, err := json.Marshal(options[0])
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+			return fmt.Errorf("failed to marshal request body: %w", err)
 		}
 
 		reqBody = string(body)
 	}
 
-	// Makes a request to a particular page and appends the response to the result
-	handlePage := func(page int) error {
+	// Makes a request to a particular page and streams its entries into the sink
+	handlePage := func(page int) (bool, error) {
 		var resultType PaginatedResponse[T]
 
 		// Override the page to be applied in createListOptionsToRequestMutator(...)
 		opts.Page = page
 
 		params := requestParams{
-			Response: &resultType,
+			ResponseHandler: func(body io.Reader) error {
+				sink.begin()
+				return decodePaginatedResponse(body, &resultType, sink.next)
+			},
 		}
 
 		if reqBody != "" {
@@ -79,7 +262,7 @@ func handlePaginatedResults[T any, O any](
 		// Make the request using doRequest
 		err := client.doRequest(ctx, method, endpoint, params, &mutator)
 		if err != nil {
-			return err
+			return false, err
 		}
 
 		// Update pagination metadata
@@ -87,10 +270,7 @@ func handlePaginatedResults[T any, O any](
 		opts.Pages = resultType.Pages
 		opts.Results = resultType.Results
 
-		// Append the data to the result slice
-		result = append(result, resultType.Data...)
-
-		return nil
+		return sink.end(), nil
 	}
 
 	// Determine starting page
@@ -102,23 +282,24 @@ func handlePaginatedResults[T any, O any](
 	}
 
 	// Get the first page
-	if err := handlePage(startingPage); err != nil {
-		return nil, err
+	more, err := handlePage(startingPage)
+	if err != nil {
+		return err
 	}
 
 	// If a specific page is defined, return the result
 	if pageDefined {
-		return result, nil
+		return nil
 	}
 
 	// Get the remaining pages
-	for page := 2; page <= opts.Pages; page++ {
-		if err := handlePage(page); err != nil {
-			return nil, err
+	for page := 2; more && page <= opts.Pages; page++ {
+		if more, err = handlePage(page); err != nil {
+			return err
 		}
 	}
 
-	return result, nil
+	return nil
 }
 
 // getPaginatedResults aggregates results from the given
@@ -177,6 +358,71 @@ func doGETRequest[T any](
 	return &resultType, nil
 }
 
+// getCachedPaginatedResults aggregates results from the given paginated endpoint,
+// returning the cached response if one is available.
+// Concurrent calls for the same uncached endpoint and ListOptions are coalesced
+// into a single set of requests.
+func getCachedPaginatedResults[T any](
+	ctx context.Context,
+	client *Client,
+	endpoint string,
+	opts *ListOptions,
+	expiry *time.Duration,
+) ([]T, error) {
+	cacheKey, err := generateListCacheURL(endpoint, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if result := client.getCachedResponse(cacheKey); result != nil {
+		return result.([]T), nil
+	}
+
+	result, err := client.inflightRequests.do(ctx, cacheKey, func(ctx context.Context) (any, error) {
+		return fetchCachedResponse(client, cacheKey, expiry, func() ([]T, error) {
+			return getPaginatedResults[T](ctx, client, endpoint, opts)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]T), nil
+}
+
+// doCachedGETRequest runs a GET request using the given client and API endpoint,
+// returning the cached response if one is available.
+// Concurrent calls for the same uncached endpoint are coalesced into a single request.
+func doCachedGETRequest[T any](
+	ctx context.Context,
+	client *Client,
+	endpoint string,
+	expiry *time.Duration,
+) (*T, error) {
+	if result := client.getCachedResponse(endpoint); result != nil {
+		result := result.(T)
+		return &result, nil
+	}
+
+	result, err := client.inflightRequests.do(ctx, endpoint, func(ctx context.Context) (any, error) {
+		response, err := fetchCachedResponse(client, endpoint, expiry, func() (*T, error) {
+			return doGETRequest[T](ctx, client, endpoint)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return *response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := result.(T)
+
+	return &response, nil
+}
+
 // doPOSTRequest runs a PUT request using the given client, API endpoint,
 // and options/body.
 func doPOSTRequest[T, O any](
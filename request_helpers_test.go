@@ -1,12 +1,15 @@
 package linodego
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
 	"net/http"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -224,6 +227,41 @@ func TestRequestHelpers_paginateSingle(t *testing.T) {
 	}
 }
 
+func TestRequestHelpers_iteratePaginated(t *testing.T) {
+	client := testutil.CreateMockClientWithError(t, NewClient)
+
+	numRequests := 0
+
+	httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/foo/bar"),
+		mockPaginatedResponse(buildPaginatedEntries(12), &numRequests))
+
+	ids := make([]int, 0)
+
+	for entry, err := range iteratePaginatedResults[testResultType](context.Background(), client, "/foo/bar", nil) {
+		require.NoError(t, err)
+
+		ids = append(ids, entry.ID)
+		if entry.ID == 4 {
+			break
+		}
+	}
+
+	// Pages should only be requested as they are consumed
+	require.Equal(t, []int{0, 1, 2, 3, 4}, ids)
+	require.Equal(t, 2, numRequests)
+
+	httpmock.RegisterRegexpResponder("GET", testutil.MockRequestURL("/foo/error"),
+		httpmock.NewStringResponder(http.StatusBadRequest, `{"errors": [{"reason": "Bad request"}]}`))
+
+	var iterErr error
+
+	for _, err := range iteratePaginatedResults[testResultType](context.Background(), client, "/foo/error", nil) {
+		iterErr = err
+	}
+
+	require.ErrorContains(t, iterErr, "Bad request")
+}
+
 func buildPaginatedEntries(numEntries int) []testResultType {
 	result := make([]testResultType, numEntries)
 
@@ -277,3 +315,100 @@ func mockPaginatedResponse(
 		)
 	}
 }
+
+func TestRequestHelpers_decodePaginatedResponse(t *testing.T) {
+	body := `{
+		"unknown": {"nested": [1, 2, 3]},
+		"data": [{"id": 1, "foo": "a"}, {"id": 2, "foo": "b"}],
+		"page": 2,
+		"pages": 5,
+		"results": 9
+	}`
+
+	var meta PaginatedResponse[testResultType]
+
+	entries := make([]testResultType, 0)
+
+	err := decodePaginatedResponse(strings.NewReader(body), &meta, func() *testResultType {
+		entries = append(entries, testResultType{})
+		return &entries[len(entries)-1]
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, 2, meta.Page)
+	require.Equal(t, 5, meta.Pages)
+	require.Equal(t, 9, meta.Results)
+	require.Equal(t, []testResultType{{ID: 1, Foo: "a"}, {ID: 2, Foo: "b"}}, entries)
+
+	err = decodePaginatedResponse(strings.NewReader(`{"data": null, "page": 1}`), &meta, func() *testResultType {
+		t.Fatal("expected no entries to be decoded")
+		return nil
+	})
+	require.NoError(t, err)
+
+	err = decodePaginatedResponse(strings.NewReader(`{"data": {"id": 1}}`), &meta, func() *testResultType {
+		return &testResultType{}
+	})
+	require.Error(t, err)
+}
+
+// benchmarkPaginatedBody returns a single page response body containing
+// 500 large entries, similar to a page of instances with specs.
+func benchmarkPaginatedBody(b *testing.B) []byte {
+	b.Helper()
+
+	entries := buildPaginatedEntries(500)
+	for i := range entries {
+		entries[i].Foo = strings.Repeat("x", 4096)
+	}
+
+	body, err := json.Marshal(PaginatedResponse[testResultType]{
+		Page:    1,
+		Pages:   1,
+		Results: len(entries),
+		Data:    entries,
+	})
+	require.NoError(b, err)
+
+	return body
+}
+
+func BenchmarkPaginatedResponse_Buffered(b *testing.B) {
+	body := benchmarkPaginatedBody(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		result := make([]testResultType, 0)
+
+		var page PaginatedResponse[testResultType]
+		if err := json.NewDecoder(bytes.NewReader(body)).Decode(&page); err != nil {
+			b.Fatal(err)
+		}
+
+		result = append(result, page.Data...)
+		_ = result
+	}
+}
+
+func BenchmarkPaginatedResponse_Streaming(b *testing.B) {
+	body := benchmarkPaginatedBody(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		result := make([]testResultType, 0)
+
+		var page PaginatedResponse[testResultType]
+
+		err := decodePaginatedResponse(bytes.NewReader(body), &page, func() *testResultType {
+			result = append(result, testResultType{})
+			return &result[len(result)-1]
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,469 @@
+package linodego
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ResourceRelation describes how a resource in a ResourceGraph depends on another.
+type ResourceRelation string
+
+// ResourceRelation enums describe how a resource in a ResourceGraph depends on another.
+const (
+	// ResourceRelationProtectedBy links an instance or NodeBalancer to a firewall assigned to it.
+	ResourceRelationProtectedBy ResourceRelation = "protected_by"
+
+	// ResourceRelationUsesVolume links an instance to a volume attached to it.
+	ResourceRelationUsesVolume ResourceRelation = "uses_volume"
+
+	// ResourceRelationBalancesTo links a NodeBalancer to an instance serving as one of its nodes.
+	ResourceRelationBalancesTo ResourceRelation = "balances_to"
+
+	// ResourceRelationMemberOf links an instance to a VPC subnet, LKE node pool or placement group it belongs to.
+	ResourceRelationMemberOf ResourceRelation = "member_of"
+
+	// ResourceRelationPartOf links a VPC subnet or LKE node pool to its VPC or cluster.
+	ResourceRelationPartOf ResourceRelation = "part_of"
+)
+
+// ResourceGraphNode is a resource in a ResourceGraph.
+type ResourceGraphNode struct {
+	Type  EntityType `json:"type"`
+	ID    int        `json:"id"`
+	Label string     `json:"label"`
+}
+
+// Key returns the node's unique key in its graph, e.g. "linode:123".
+func (n ResourceGraphNode) Key() string {
+	return resourceGraphKey(n.Type, n.ID)
+}
+
+// ResourceGraphEdge is a dependency between two resources in a ResourceGraph:
+// the resource From depends on the resource To.
+type ResourceGraphEdge struct {
+	From     string           `json:"from"`
+	To       string           `json:"to"`
+	Relation ResourceRelation `json:"relation"`
+}
+
+// ResourceGraph is a directed graph of the dependencies between the resources on an account.
+// An edge points from a resource to a resource it depends on, so the dependents of a resource
+// are the resources which may break if it is deleted.
+type ResourceGraph struct {
+	nodes map[string]ResourceGraphNode
+	edges []ResourceGraphEdge
+
+	// edgeSet contains every edge in edges, so duplicates are found without scanning them
+	edgeSet map[ResourceGraphEdge]struct{}
+
+	// outgoing and incoming map node keys to the indexes of their edges
+	outgoing map[string][]int
+	incoming map[string][]int
+}
+
+func newResourceGraph() *ResourceGraph {
+	return &ResourceGraph{
+		nodes:    make(map[string]ResourceGraphNode),
+		edgeSet:  make(map[ResourceGraphEdge]struct{}),
+		outgoing: make(map[string][]int),
+		incoming: make(map[string][]int),
+	}
+}
+
+func resourceGraphKey(entityType EntityType, id int) string {
+	return string(entityType) + ":" + strconv.Itoa(id)
+}
+
+// BuildResourceGraph lists the account's instances, volumes, firewalls, NodeBalancers, VPCs,
+// LKE clusters and placement groups, and returns a graph of the dependencies between them.
+//
+// NodeBalancer nodes are linked to the instances owning their addresses. Relationships to
+// resources which weren't listed, e.g. interfaces of deleted instances, are omitted.
+func (c *Client) BuildResourceGraph(ctx context.Context) (*ResourceGraph, error) {
+	graph := newResourceGraph()
+
+	instances, err := c.ListInstances(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list instances: %w", err)
+	}
+
+	instancesByIP := make(map[string]int)
+
+	for _, instance := range instances {
+		graph.addNode(EntityLinode, instance.ID, instance.Label)
+
+		for _, ip := range instance.IPv4 {
+			instancesByIP[ip.String()] = instance.ID
+		}
+	}
+
+	for _, build := range []func(ctx context.Context, graph *ResourceGraph) error{
+		c.graphVolumes,
+		func(ctx context.Context, graph *ResourceGraph) error {
+			return c.graphNodeBalancers(ctx, graph, instancesByIP)
+		},
+		c.graphFirewalls,
+		c.graphVPCs,
+		c.graphLKEClusters,
+		c.graphPlacementGroups,
+	} {
+		if err := build(ctx, graph); err != nil {
+			return nil, err
+		}
+	}
+
+	return graph, nil
+}
+
+func (c *Client) graphVolumes(ctx context.Context, graph *ResourceGraph) error {
+	volumes, err := c.ListVolumes(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list volumes: %w", err)
+	}
+
+	for _, volume := range volumes {
+		graph.addNode(EntityVolume, volume.ID, volume.Label)
+
+		if volume.LinodeID != nil {
+			graph.addEdge(EntityLinode, *volume.LinodeID, EntityVolume, volume.ID, ResourceRelationUsesVolume)
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) graphNodeBalancers(ctx context.Context, graph *ResourceGraph, instancesByIP map[string]int) error {
+	nodebalancers, err := c.ListNodeBalancers(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list NodeBalancers: %w", err)
+	}
+
+	for _, nodebalancer := range nodebalancers {
+		label := ""
+		if nodebalancer.Label != nil {
+			label = *nodebalancer.Label
+		}
+
+		graph.addNode(EntityNodebalancer, nodebalancer.ID, label)
+
+		configs, err := c.ListNodeBalancerConfigs(ctx, nodebalancer.ID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list configs of NodeBalancer %d: %w", nodebalancer.ID, err)
+		}
+
+		for _, config := range configs {
+			nodes, err := c.ListNodeBalancerNodes(ctx, nodebalancer.ID, config.ID, nil)
+			if err != nil {
+				return fmt.Errorf("failed to list nodes of NodeBalancer %d config %d: %w",
+					nodebalancer.ID, config.ID, err)
+			}
+
+			for _, node := range nodes {
+				host, _, err := net.SplitHostPort(node.Address)
+				if err != nil {
+					host = node.Address
+				}
+
+				if instanceID, ok := instancesByIP[host]; ok {
+					graph.addEdge(EntityNodebalancer, nodebalancer.ID, EntityLinode, instanceID, ResourceRelationBalancesTo)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) graphFirewalls(ctx context.Context, graph *ResourceGraph) error {
+	firewalls, err := c.ListFirewalls(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list firewalls: %w", err)
+	}
+
+	for _, firewall := range firewalls {
+		graph.addNode(EntityFirewall, firewall.ID, firewall.Label)
+
+		for _, entity := range firewall.Entities {
+			// Firewalls assigned to Linode interfaces protect the interface's instance
+			if entity.Type == FirewallDeviceLinodeInterface && entity.ParentEntity != nil {
+				entity = *entity.ParentEntity
+			}
+
+			switch entity.Type {
+			case FirewallDeviceLinode:
+				graph.addEdge(EntityLinode, entity.ID, EntityFirewall, firewall.ID, ResourceRelationProtectedBy)
+			case FirewallDeviceNodeBalancer:
+				graph.addEdge(EntityNodebalancer, entity.ID, EntityFirewall, firewall.ID, ResourceRelationProtectedBy)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) graphVPCs(ctx context.Context, graph *ResourceGraph) error {
+	vpcs, err := c.ListVPCs(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list VPCs: %w", err)
+	}
+
+	for _, vpc := range vpcs {
+		graph.addNode(EntityVPC, vpc.ID, vpc.Label)
+
+		for _, subnet := range vpc.Subnets {
+			graph.addNode(EntityVPCSubnet, subnet.ID, subnet.Label)
+			graph.addEdge(EntityVPCSubnet, subnet.ID, EntityVPC, vpc.ID, ResourceRelationPartOf)
+
+			for _, linode := range subnet.Linodes {
+				graph.addEdge(EntityLinode, linode.ID, EntityVPCSubnet, subnet.ID, ResourceRelationMemberOf)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) graphLKEClusters(ctx context.Context, graph *ResourceGraph) error {
+	clusters, err := c.ListLKEClusters(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list LKE clusters: %w", err)
+	}
+
+	for _, cluster := range clusters {
+		graph.addNode(EntityLKECluster, cluster.ID, cluster.Label)
+
+		pools, err := c.ListLKENodePools(ctx, cluster.ID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list node pools of LKE cluster %d: %w", cluster.ID, err)
+		}
+
+		for _, pool := range pools {
+			label := pool.Type
+			if pool.Label != nil && *pool.Label != "" {
+				label = *pool.Label
+			}
+
+			graph.addNode(EntityLKENodePool, pool.ID, label)
+			graph.addEdge(EntityLKENodePool, pool.ID, EntityLKECluster, cluster.ID, ResourceRelationPartOf)
+
+			for _, linode := range pool.Linodes {
+				graph.addEdge(EntityLinode, linode.InstanceID, EntityLKENodePool, pool.ID, ResourceRelationMemberOf)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) graphPlacementGroups(ctx context.Context, graph *ResourceGraph) error {
+	groups, err := c.ListPlacementGroups(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list placement groups: %w", err)
+	}
+
+	for _, group := range groups {
+		graph.addNode(EntityPlacementGroup, group.ID, group.Label)
+
+		for _, member := range group.Members {
+			graph.addEdge(EntityLinode, member.LinodeID, EntityPlacementGroup, group.ID, ResourceRelationMemberOf)
+		}
+	}
+
+	return nil
+}
+
+func (g *ResourceGraph) addNode(entityType EntityType, id int, label string) {
+	node := ResourceGraphNode{Type: entityType, ID: id, Label: label}
+	g.nodes[node.Key()] = node
+}
+
+// addEdge adds an edge between the given resources if both of them are in the graph.
+func (g *ResourceGraph) addEdge(
+	fromType EntityType, fromID int,
+	toType EntityType, toID int,
+	relation ResourceRelation,
+) {
+	from, to := resourceGraphKey(fromType, fromID), resourceGraphKey(toType, toID)
+
+	if _, ok := g.nodes[from]; !ok {
+		return
+	}
+
+	if _, ok := g.nodes[to]; !ok {
+		return
+	}
+
+	edge := ResourceGraphEdge{From: from, To: to, Relation: relation}
+	if _, ok := g.edgeSet[edge]; ok {
+		return
+	}
+
+	g.edgeSet[edge] = struct{}{}
+	g.edges = append(g.edges, edge)
+	g.outgoing[from] = append(g.outgoing[from], len(g.edges)-1)
+	g.incoming[to] = append(g.incoming[to], len(g.edges)-1)
+}
+
+// Node returns the node of the resource with the given type and ID, if it's in the graph.
+func (g *ResourceGraph) Node(entityType EntityType, id int) (ResourceGraphNode, bool) {
+	node, ok := g.nodes[resourceGraphKey(entityType, id)]
+	return node, ok
+}
+
+// Nodes returns all nodes in the graph, ordered by type and ID.
+func (g *ResourceGraph) Nodes() []ResourceGraphNode {
+	result := make([]ResourceGraphNode, 0, len(g.nodes))
+	for _, node := range g.nodes {
+		result = append(result, node)
+	}
+
+	sortResourceGraphNodes(result)
+
+	return result
+}
+
+// Edges returns all edges in the graph, in the order they were discovered.
+func (g *ResourceGraph) Edges() []ResourceGraphEdge {
+	return slices.Clone(g.edges)
+}
+
+// Dependents returns the resources which directly or transitively depend on the given resource,
+// i.e. which may break if it is deleted, ordered by type and ID.
+func (g *ResourceGraph) Dependents(entityType EntityType, id int) []ResourceGraphNode {
+	return g.reachable(resourceGraphKey(entityType, id), g.incoming, func(edge ResourceGraphEdge) string {
+		return edge.From
+	})
+}
+
+// Dependencies returns the resources which the given resource directly or transitively
+// depends on, ordered by type and ID.
+func (g *ResourceGraph) Dependencies(entityType EntityType, id int) []ResourceGraphNode {
+	return g.reachable(resourceGraphKey(entityType, id), g.outgoing, func(edge ResourceGraphEdge) string {
+		return edge.To
+	})
+}
+
+func (g *ResourceGraph) reachable(
+	start string,
+	adjacent map[string][]int,
+	next func(edge ResourceGraphEdge) string,
+) []ResourceGraphNode {
+	visited := map[string]bool{start: true}
+	queue := []string{start}
+	result := make([]ResourceGraphNode, 0)
+
+	for len(queue) > 0 {
+		key := queue[0]
+		queue = queue[1:]
+
+		for _, index := range adjacent[key] {
+			neighbor := next(g.edges[index])
+			if visited[neighbor] {
+				continue
+			}
+
+			visited[neighbor] = true
+			queue = append(queue, neighbor)
+			result = append(result, g.nodes[neighbor])
+		}
+	}
+
+	sortResourceGraphNodes(result)
+
+	return result
+}
+
+// ConnectedComponents returns the groups of resources which are connected to each other,
+// ignoring the direction of dependencies. Resources without any dependencies form their own
+// components. Each component is ordered by type and ID, and components are ordered by their
+// first node.
+func (g *ResourceGraph) ConnectedComponents() [][]ResourceGraphNode {
+	visited := make(map[string]bool, len(g.nodes))
+	result := make([][]ResourceGraphNode, 0)
+
+	for _, node := range g.Nodes() {
+		if visited[node.Key()] {
+			continue
+		}
+
+		visited[node.Key()] = true
+		component := []ResourceGraphNode{node}
+		queue := []string{node.Key()}
+
+		for len(queue) > 0 {
+			key := queue[0]
+			queue = queue[1:]
+
+			for _, index := range slices.Concat(g.outgoing[key], g.incoming[key]) {
+				edge := g.edges[index]
+
+				neighbor := edge.To
+				if neighbor == key {
+					neighbor = edge.From
+				}
+
+				if visited[neighbor] {
+					continue
+				}
+
+				visited[neighbor] = true
+				queue = append(queue, neighbor)
+				component = append(component, g.nodes[neighbor])
+			}
+		}
+
+		sortResourceGraphNodes(component)
+		result = append(result, component)
+	}
+
+	return result
+}
+
+// MarshalJSON encodes the graph as an object containing its nodes and edges.
+func (g *ResourceGraph) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Nodes []ResourceGraphNode `json:"nodes"`
+		Edges []ResourceGraphEdge `json:"edges"`
+	}{
+		Nodes: g.Nodes(),
+		Edges: g.Edges(),
+	})
+}
+
+// WriteDOT writes the graph to w in the Graphviz DOT format.
+func (g *ResourceGraph) WriteDOT(w io.Writer) error {
+	var builder strings.Builder
+
+	builder.WriteString("digraph resources {\n")
+
+	for _, node := range g.Nodes() {
+		label := fmt.Sprintf("%s\n%s %d", node.Label, node.Type, node.ID)
+		fmt.Fprintf(&builder, "  %s [label=%s];\n", strconv.Quote(node.Key()), strconv.Quote(label))
+	}
+
+	for _, edge := range g.edges {
+		fmt.Fprintf(&builder, "  %s -> %s [label=%s];\n",
+			strconv.Quote(edge.From), strconv.Quote(edge.To), strconv.Quote(string(edge.Relation)))
+	}
+
+	builder.WriteString("}\n")
+
+	if _, err := io.WriteString(w, builder.String()); err != nil {
+		return fmt.Errorf("failed to write resource graph: %w", err)
+	}
+
+	return nil
+}
+
+func sortResourceGraphNodes(nodes []ResourceGraphNode) {
+	slices.SortFunc(nodes, func(a, b ResourceGraphNode) int {
+		return cmp.Or(cmp.Compare(a.Type, b.Type), cmp.Compare(a.ID, b.ID))
+	})
+}
@@ -0,0 +1,297 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// SagaStepStatus is the outcome of a step in a Saga.
+type SagaStepStatus string
+
+// SagaStepStatus enums represent the outcomes of a step in a Saga.
+const (
+	SagaStepPending        SagaStepStatus = "pending"
+	SagaStepCompleted      SagaStepStatus = "completed"
+	SagaStepFailed         SagaStepStatus = "failed"
+	SagaStepRolledBack     SagaStepStatus = "rolled_back"
+	SagaStepRollbackFailed SagaStepStatus = "rollback_failed"
+)
+
+// SagaState holds the values produced by the completed steps of a Saga,
+// so that later steps can reference them.
+type SagaState struct {
+	lock   sync.RWMutex
+	values map[string]any
+}
+
+// Get returns the value produced by the step with the given name, if it has completed.
+func (s *SagaState) Get(step string) (any, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	value, ok := s.values[step]
+
+	return value, ok
+}
+
+func (s *SagaState) set(step string, value any) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.values[step] = value
+}
+
+// SagaValue returns the value produced by the step with the given name,
+// or nil if the step has not completed or produced a value of another type.
+//
+// For example, to create an instance in a VPC subnet created by an earlier step:
+//
+//	subnet := linodego.SagaValue[linodego.VPCSubnet](state, "subnet")
+func SagaValue[T any](state *SagaState, step string) *T {
+	value, ok := state.Get(step)
+	if !ok {
+		return nil
+	}
+
+	result, _ := value.(*T)
+
+	return result
+}
+
+// SagaStepResult is the outcome of a step in a Saga.
+type SagaStepResult struct {
+	Name   string
+	Status SagaStepStatus
+
+	// Value is the value produced by the step, if it completed or failed after
+	// partially creating its resource.
+	Value any
+
+	// Err is the error returned by a failed step, or by the compensation of a step
+	// which could not be rolled back. If a failed step could not be rolled back,
+	// both errors are joined.
+	Err error
+}
+
+// SagaReport summarizes the outcome of a Saga.
+type SagaReport struct {
+	// Steps contains the result of each step in the order the steps were added.
+	Steps []SagaStepResult
+
+	// State holds the values produced by the saga's steps.
+	State *SagaState
+}
+
+// Leftovers returns the results of the steps which could not be rolled back,
+// i.e. whose resources may need to be cleaned up manually.
+func (r *SagaReport) Leftovers() []SagaStepResult {
+	result := make([]SagaStepResult, 0)
+
+	for _, step := range r.Steps {
+		if step.Status == SagaStepRollbackFailed {
+			result = append(result, step)
+		}
+	}
+
+	return result
+}
+
+// SagaError is returned when a step of a Saga fails.
+type SagaError struct {
+	// Step is the name of the failed step.
+	Step string
+
+	// Err is the error returned by the failed step.
+	Err error
+
+	// Leftovers contains the results of the steps which could not be rolled back.
+	Leftovers []SagaStepResult
+}
+
+func (e *SagaError) Error() string {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "saga step %s failed: %s", e.Step, e.Err)
+
+	for _, leftover := range e.Leftovers {
+		fmt.Fprintf(&builder, "; failed to roll back step %s: %s", leftover.Name, leftover.Err)
+	}
+
+	return builder.String()
+}
+
+func (e *SagaError) Unwrap() error {
+	return e.Err
+}
+
+// Saga runs a sequence of steps which create resources, rolling back the completed
+// steps in reverse order using their compensating actions if any step fails.
+type Saga struct {
+	client *Client
+	steps  []sagaStep
+}
+
+type sagaStep struct {
+	name       string
+	create     func(ctx context.Context, client *Client, state *SagaState) (any, error)
+	compensate func(ctx context.Context, client *Client, value any) error
+}
+
+// NewSaga creates a new empty Saga.
+func (c *Client) NewSaga() *Saga {
+	return &Saga{client: c}
+}
+
+// AddSagaStep adds a step to the given saga and returns the saga.
+//
+// The create function creates the step's resource, and may reference the values produced by
+// earlier steps using SagaValue(...). The compensate function deletes the created resource
+// when rolling back; it may be nil if the step has nothing to undo. Compensations returning
+// a 404 error are considered successful, as the resource no longer exists.
+//
+// If create fails after partially creating its resource, e.g. an instance which was created
+// but failed to boot, it may return the resource alongside the error so that the failed step
+// is compensated as well. Compensate is never called with a nil value.
+//
+// For example:
+//
+//	saga := client.NewSaga()
+//	linodego.AddSagaStep(saga, "vpc",
+//		func(ctx context.Context, client *linodego.Client, _ *linodego.SagaState) (*linodego.VPC, error) {
+//			return client.CreateVPC(ctx, vpcOpts)
+//		},
+//		func(ctx context.Context, client *linodego.Client, vpc *linodego.VPC) error {
+//			return client.DeleteVPC(ctx, vpc.ID)
+//		})
+func AddSagaStep[T any](
+	saga *Saga,
+	name string,
+	create func(ctx context.Context, client *Client, state *SagaState) (*T, error),
+	compensate func(ctx context.Context, client *Client, value *T) error,
+) *Saga {
+	step := sagaStep{
+		name: name,
+		create: func(ctx context.Context, client *Client, state *SagaState) (any, error) {
+			value, err := create(ctx, client, state)
+			if value == nil {
+				// Avoid storing a typed nil, which would compare as non-nil
+				return nil, err
+			}
+
+			return value, err
+		},
+	}
+
+	if compensate != nil {
+		step.compensate = func(ctx context.Context, client *Client, value any) error {
+			typed, ok := value.(*T)
+			if !ok || typed == nil {
+				return nil
+			}
+
+			return compensate(ctx, client, typed)
+		}
+	}
+
+	saga.steps = append(saga.steps, step)
+
+	return saga
+}
+
+// Run runs the saga's steps in order. If a step fails, the completed steps are rolled back
+// in reverse order and a *SagaError is returned alongside the report.
+// An error is returned without running any steps if the saga has duplicate step names.
+//
+// Rollbacks are performed even if ctx has been canceled, so that no resources are left behind.
+func (s *Saga) Run(ctx context.Context) (*SagaReport, error) {
+	names := make(map[string]struct{}, len(s.steps))
+
+	for _, step := range s.steps {
+		if _, ok := names[step.name]; ok {
+			return nil, fmt.Errorf("duplicate saga step %s", step.name)
+		}
+
+		names[step.name] = struct{}{}
+	}
+
+	report := &SagaReport{
+		Steps: make([]SagaStepResult, len(s.steps)),
+		State: &SagaState{values: make(map[string]any)},
+	}
+
+	for i, step := range s.steps {
+		report.Steps[i] = SagaStepResult{Name: step.name, Status: SagaStepPending}
+	}
+
+	for i, step := range s.steps {
+		err := ctx.Err()
+		if err == nil {
+			report.Steps[i].Value, err = step.create(ctx, s.client, report.State)
+		}
+
+		if err != nil {
+			report.Steps[i].Status = SagaStepFailed
+			report.Steps[i].Err = err
+
+			rollbackCtx := context.WithoutCancel(ctx)
+
+			// Clean up whatever the failed step managed to create before failing
+			if report.Steps[i].Value != nil && step.compensate != nil {
+				if compensateErr := s.compensate(rollbackCtx, step, report.Steps[i].Value); compensateErr != nil {
+					report.Steps[i].Status = SagaStepRollbackFailed
+					report.Steps[i].Err = errors.Join(err, compensateErr)
+				}
+			}
+
+			s.rollback(rollbackCtx, report, i)
+
+			return report, &SagaError{Step: step.name, Err: err, Leftovers: report.Leftovers()}
+		}
+
+		report.Steps[i].Status = SagaStepCompleted
+		report.State.set(step.name, report.Steps[i].Value)
+	}
+
+	return report, nil
+}
+
+// rollback compensates the completed steps before the given index in reverse order.
+func (s *Saga) rollback(ctx context.Context, report *SagaReport, failed int) {
+	for i := failed - 1; i >= 0; i-- {
+		step := s.steps[i]
+		result := &report.Steps[i]
+
+		if step.compensate == nil {
+			result.Status = SagaStepRolledBack
+			continue
+		}
+
+		if err := s.compensate(ctx, step, result.Value); err != nil {
+			result.Status = SagaStepRollbackFailed
+			result.Err = err
+
+			continue
+		}
+
+		result.Status = SagaStepRolledBack
+	}
+}
+
+func (s *Saga) compensate(ctx context.Context, step sagaStep, value any) (err error) {
+	defer func() {
+		// Make sure a panicking compensation doesn't prevent the remaining rollback
+		if r := recover(); r != nil {
+			err = fmt.Errorf("compensation panicked: %v", r)
+		}
+	}()
+
+	err = step.compensate(ctx, s.client, value)
+	if err != nil && !IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
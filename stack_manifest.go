@@ -0,0 +1,1046 @@
+package linodego
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// StackManifest declaratively describes a set of resources using their create options.
+// Resources are matched to live resources by label, or by domain name for domains.
+//
+// Resources can't reference other resources in the same manifest, as their IDs aren't known
+// until they are created. Referenced IDs, e.g. a volume's linode_id or an instance's firewall_id,
+// must refer to existing resources, which is checked when the stack is planned.
+//
+// Manifests can be written in YAML or JSON using the API's field names, for example:
+//
+//	tags: [my-stack]
+//	instances:
+//	  - label: web-1
+//	    region: us-east
+//	    type: g6-standard-1
+//	    image: linode/ubuntu24.04
+//	domains:
+//	  - domain: example.com
+//	    type: master
+//	    soa_email: admin@example.com
+//	    records:
+//	      - type: A
+//	        name: www
+//	        target: 192.0.2.1
+type StackManifest struct {
+	// Tags are applied to all top-level resources in the stack. If set, live resources with all
+	// of these tags which are not in the manifest are planned for deletion.
+	Tags []string `json:"tags,omitzero"`
+
+	Instances     []InstanceCreateOptions     `json:"instances,omitzero"`
+	Volumes       []VolumeCreateOptions       `json:"volumes,omitzero"`
+	Firewalls     []FirewallCreateOptions     `json:"firewalls,omitzero"`
+	Domains       []StackManifestDomain       `json:"domains,omitzero"`
+	NodeBalancers []NodeBalancerCreateOptions `json:"nodebalancers,omitzero"`
+}
+
+// StackManifestDomain describes a domain and its records in a StackManifest.
+type StackManifestDomain struct {
+	DomainCreateOptions
+
+	// Records are the domain's records, matched to live records by type, name and target.
+	// Live records which are not listed are planned for deletion.
+	Records []DomainRecordCreateOptions `json:"records,omitzero"`
+}
+
+// ParseStackManifest parses a YAML or JSON StackManifest.
+// Unknown fields are rejected to catch typos, except within instances,
+// which are decoded by InstanceCreateOptions itself.
+func ParseStackManifest(data []byte) (*StackManifest, error) {
+	var raw any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse stack manifest: %w", err)
+	}
+
+	// Convert the document to JSON so the API's field names are used
+	contents, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse stack manifest: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(contents))
+	decoder.DisallowUnknownFields()
+
+	var result StackManifest
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse stack manifest: %w", err)
+	}
+
+	return &result, nil
+}
+
+// LoadStackManifest reads and parses the YAML or JSON StackManifest at the given path.
+func LoadStackManifest(path string) (*StackManifest, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stack manifest %s: %w", path, err)
+	}
+
+	return ParseStackManifest(contents)
+}
+
+// StackChangeAction is the action of a planned StackChange.
+type StackChangeAction string
+
+// StackChangeAction enums represent the actions of a planned StackChange.
+const (
+	StackCreate StackChangeAction = "create"
+	StackUpdate StackChangeAction = "update"
+	StackDelete StackChangeAction = "delete"
+)
+
+// StackFieldChange is a change to a single field of an updated resource.
+type StackFieldChange struct {
+	Field string
+	Old   any
+	New   any
+}
+
+// StackChange is a planned change to a resource.
+type StackChange struct {
+	Action StackChangeAction
+
+	// ResourceType is the type of the changed resource, e.g. "instance" or "domain_record".
+	ResourceType string
+
+	// Label identifies the changed resource, e.g. the instance label or
+	// the domain and name of a domain record.
+	Label string
+
+	// ID is the ID of the live resource for updates and deletes.
+	ID int
+
+	// Fields lists the changed fields for updates.
+	Fields []StackFieldChange
+
+	apply func(ctx context.Context, client *Client) error
+}
+
+func (c StackChange) String() string {
+	if len(c.Fields) == 0 {
+		return fmt.Sprintf("%s %s %s", c.Action, c.ResourceType, c.Label)
+	}
+
+	fields := make([]string, len(c.Fields))
+	for i, field := range c.Fields {
+		fields[i] = field.Field
+	}
+
+	return fmt.Sprintf("%s %s %s (%s)", c.Action, c.ResourceType, c.Label, strings.Join(fields, ", "))
+}
+
+// StackPlan is the set of changes required to make the live account match a StackManifest.
+type StackPlan struct {
+	Changes []StackChange
+}
+
+// Empty returns whether the live account already matches the manifest.
+func (p *StackPlan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// PlanStack compares the given manifest with the live account, returning the changes
+// required to make the account match the manifest.
+//
+// Creates are planned before updates, and deletes are planned last with dependents deleted
+// first, e.g. instances before the volumes attached to them. Volumes which are still attached
+// when they are deleted are detached first.
+//
+// Only fields which can be changed using the resource's update options are compared; changes
+// to other fields, e.g. an instance's region, are not detected.
+func (c *Client) PlanStack(ctx context.Context, manifest *StackManifest) (*StackPlan, error) {
+	if err := manifest.validate(); err != nil {
+		return nil, err
+	}
+
+	planner := &stackPlanner{client: c, manifest: manifest}
+
+	steps := []func(ctx context.Context) error{
+		planner.validateReferences,
+		planner.planFirewalls,
+		planner.planInstances,
+		planner.planVolumes,
+		planner.planDomains,
+		planner.planNodeBalancers,
+	}
+
+	for _, step := range steps {
+		if err := step(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	// Delete dependents before the resources they may depend on
+	slices.SortStableFunc(planner.deletes, func(a, b StackChange) int {
+		return cmp.Compare(slices.Index(stackDeleteOrder, a.ResourceType), slices.Index(stackDeleteOrder, b.ResourceType))
+	})
+
+	changes := slices.Concat(planner.creates, planner.updates, planner.deletes)
+
+	return &StackPlan{Changes: changes}, nil
+}
+
+// stackDeleteOrder is the order in which deleted resources are deleted by type, so that
+// dependents are deleted before the resources they depend on. In particular, instances
+// are deleted before the volumes which may be attached to them.
+var stackDeleteOrder = []string{
+	"nodebalancer_node",
+	"nodebalancer_config",
+	"nodebalancer",
+	"domain_record",
+	"domain",
+	"instance",
+	"volume",
+	"firewall",
+}
+
+// ApplyStackPlan executes the changes of the given plan in order, stopping at the first failure.
+// The returned report contains the outcome of each change, keyed by its position in the plan
+// and its description, e.g. "3: delete instance web-1".
+func (c *Client) ApplyStackPlan(ctx context.Context, plan *StackPlan) (*BatchReport, error) {
+	operations := make([]BatchOperation, len(plan.Changes))
+
+	for i, change := range plan.Changes {
+		operations[i] = BatchOperation{Key: fmt.Sprintf("%d: %s", i, change), Run: change.apply}
+	}
+
+	report, err := c.RunBatch(ctx, operations, &BatchOptions{Concurrency: 1, Policy: BatchStopOnError})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, report.Err()
+}
+
+func (m *StackManifest) validate() error {
+	labels := map[string][]string{
+		"instance": {},
+		"volume":   {},
+		"firewall": {},
+		"domain":   {},
+	}
+
+	for _, instance := range m.Instances {
+		labels["instance"] = append(labels["instance"], instance.Label)
+	}
+
+	for _, volume := range m.Volumes {
+		labels["volume"] = append(labels["volume"], volume.Label)
+	}
+
+	for _, firewall := range m.Firewalls {
+		labels["firewall"] = append(labels["firewall"], firewall.Label)
+	}
+
+	for _, domain := range m.Domains {
+		labels["domain"] = append(labels["domain"], domain.Domain)
+
+		records := make([]string, len(domain.Records))
+		for i, record := range domain.Records {
+			records[i] = stackRecordKey(record.Type, record.Name, record.Target)
+		}
+
+		if err := validateStackLabels(domain.Domain+" record", records); err != nil {
+			return err
+		}
+	}
+
+	nodeBalancers := make([]string, len(m.NodeBalancers))
+
+	for i, nodeBalancer := range m.NodeBalancers {
+		if nodeBalancer.Label != nil {
+			nodeBalancers[i] = *nodeBalancer.Label
+		}
+
+		ports := make([]string, len(nodeBalancer.Configs))
+		for j, config := range nodeBalancer.Configs {
+			ports[j] = fmt.Sprint(config.Port)
+		}
+
+		if err := validateStackLabels(nodeBalancers[i]+" config port", ports); err != nil {
+			return err
+		}
+	}
+
+	labels["nodebalancer"] = nodeBalancers
+
+	for _, resourceType := range slices.Sorted(maps.Keys(labels)) {
+		if err := validateStackLabels(resourceType, labels[resourceType]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateStackLabels(resourceType string, labels []string) error {
+	seen := make(map[string]struct{}, len(labels))
+
+	for _, label := range labels {
+		if label == "" {
+			return fmt.Errorf("stack manifest %s is missing a label", resourceType)
+		}
+
+		if _, ok := seen[label]; ok {
+			return fmt.Errorf("duplicate stack manifest %s %s", resourceType, label)
+		}
+
+		seen[label] = struct{}{}
+	}
+
+	return nil
+}
+
+// stackPlanner accumulates the changes of a StackPlan.
+type stackPlanner struct {
+	client   *Client
+	manifest *StackManifest
+
+	creates []StackChange
+	updates []StackChange
+	deletes []StackChange
+}
+
+func (p *stackPlanner) add(change StackChange) {
+	switch change.Action {
+	case StackCreate:
+		p.creates = append(p.creates, change)
+	case StackUpdate:
+		p.updates = append(p.updates, change)
+	case StackDelete:
+		p.deletes = append(p.deletes, change)
+	}
+}
+
+// validateReferences checks that the resources referenced by ID in the manifest exist.
+func (p *stackPlanner) validateReferences(ctx context.Context) error {
+	checkExists := func(resourceType, label, field string, id int, get func(ctx context.Context, id int) error) error {
+		if id == 0 {
+			return nil
+		}
+
+		if err := get(ctx, id); err != nil {
+			return fmt.Errorf("stack manifest %s %s references missing %s %d: %w", resourceType, label, field, id, err)
+		}
+
+		return nil
+	}
+
+	getInstance := func(ctx context.Context, id int) error {
+		_, err := p.client.GetInstance(ctx, id)
+		return err
+	}
+
+	getFirewall := func(ctx context.Context, id int) error {
+		_, err := p.client.GetFirewall(ctx, id)
+		return err
+	}
+
+	for _, instance := range p.manifest.Instances {
+		if err := checkExists("instance", instance.Label, "firewall_id", instance.FirewallID, getFirewall); err != nil {
+			return err
+		}
+	}
+
+	for _, volume := range p.manifest.Volumes {
+		if err := checkExists("volume", volume.Label, "linode_id", volume.LinodeID, getInstance); err != nil {
+			return err
+		}
+	}
+
+	for _, nodeBalancer := range p.manifest.NodeBalancers {
+		label := ""
+		if nodeBalancer.Label != nil {
+			label = *nodeBalancer.Label
+		}
+
+		if err := checkExists("nodebalancer", label, "firewall_id", nodeBalancer.FirewallID, getFirewall); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isStackResource returns whether a live resource with the given tags belongs to the stack,
+// i.e. may be deleted if it is not in the manifest.
+func (p *stackPlanner) isStackResource(tags []string) bool {
+	if len(p.manifest.Tags) == 0 {
+		return false
+	}
+
+	for _, tag := range p.manifest.Tags {
+		if !slices.Contains(tags, tag) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (p *stackPlanner) withStackTags(tags []string) []string {
+	result := slices.Clone(tags)
+
+	for _, tag := range p.manifest.Tags {
+		if !slices.Contains(result, tag) {
+			result = append(result, tag)
+		}
+	}
+
+	return result
+}
+
+// planTopLevel plans the changes to a top-level resource type whose live resources
+// are listed using list.
+func planTopLevel[T, C, U any](
+	ctx context.Context,
+	p *stackPlanner,
+	resourceType string,
+	desired []C,
+	list func(ctx context.Context, opts *ListOptions) ([]T, error),
+	describe func(live *T) (id int, label string, tags []string, updateOpts U),
+	describeDesired func(opts C) string,
+	handle stackResourceHandlers[T, C, U],
+) error {
+	if len(desired) == 0 && len(p.manifest.Tags) == 0 {
+		return nil
+	}
+
+	live, err := list(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list %ss: %w", resourceType, err)
+	}
+
+	byLabel := make(map[string]*T, len(live))
+
+	for i := range live {
+		_, label, _, _ := describe(&live[i])
+		byLabel[label] = &live[i]
+	}
+
+	desiredLabels := make(map[string]struct{}, len(desired))
+
+	for _, opts := range desired {
+		label := describeDesired(opts)
+		desiredLabels[label] = struct{}{}
+
+		current, ok := byLabel[label]
+		if !ok {
+			p.add(StackChange{
+				Action:       StackCreate,
+				ResourceType: resourceType,
+				Label:        label,
+				apply: func(ctx context.Context, client *Client) error {
+					return handle.create(ctx, client, opts)
+				},
+			})
+
+			continue
+		}
+
+		id, _, _, liveUpdateOpts := describe(current)
+
+		desiredUpdateOpts, err := convertStackOptions[U](opts)
+		if err != nil {
+			return err
+		}
+
+		fields, err := diffStackFields(liveUpdateOpts, desiredUpdateOpts, handle.ignoreFields...)
+		if err != nil {
+			return err
+		}
+
+		if len(fields) > 0 {
+			p.add(StackChange{
+				Action:       StackUpdate,
+				ResourceType: resourceType,
+				Label:        label,
+				ID:           id,
+				Fields:       fields,
+				apply: func(ctx context.Context, client *Client) error {
+					return handle.update(ctx, client, id, desiredUpdateOpts)
+				},
+			})
+		}
+
+		if handle.planChildren != nil {
+			if err := handle.planChildren(ctx, current, opts); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, item := range live {
+		id, label, tags, _ := describe(&item)
+
+		if _, ok := desiredLabels[label]; ok || !p.isStackResource(tags) {
+			continue
+		}
+
+		p.add(StackChange{
+			Action:       StackDelete,
+			ResourceType: resourceType,
+			Label:        label,
+			ID:           id,
+			apply: func(ctx context.Context, client *Client) error {
+				return handle.delete(client, ctx, id)
+			},
+		})
+	}
+
+	return nil
+}
+
+// stackResourceHandlers describes how to change the resources of a top-level resource type.
+type stackResourceHandlers[T, C, U any] struct {
+	create func(ctx context.Context, client *Client, opts C) error
+	update func(ctx context.Context, client *Client, id int, opts U) error
+	delete func(client *Client, ctx context.Context, id int) error
+
+	// ignoreFields lists the fields which are not compared.
+	ignoreFields []string
+
+	// planChildren plans the changes to the children of an existing resource.
+	planChildren func(ctx context.Context, live *T, opts C) error
+}
+
+func (p *stackPlanner) planInstances(ctx context.Context) error {
+	desired := make([]InstanceCreateOptions, len(p.manifest.Instances))
+	for i, opts := range p.manifest.Instances {
+		opts.Tags = p.withStackTags(opts.Tags)
+		desired[i] = opts
+	}
+
+	return planTopLevel(ctx, p, "instance", desired, p.client.ListInstances,
+		func(live *Instance) (int, string, []string, InstanceUpdateOptions) {
+			return live.ID, live.Label, live.Tags, live.GetUpdateOptions()
+		},
+		func(opts InstanceCreateOptions) string { return opts.Label },
+		stackResourceHandlers[Instance, InstanceCreateOptions, InstanceUpdateOptions]{
+			create: func(ctx context.Context, client *Client, opts InstanceCreateOptions) error {
+				_, err := client.CreateInstance(ctx, opts)
+				return err
+			},
+			update: func(ctx context.Context, client *Client, id int, opts InstanceUpdateOptions) error {
+				_, err := client.UpdateInstance(ctx, id, opts)
+				return err
+			},
+			delete: (*Client).DeleteInstance,
+		})
+}
+
+func (p *stackPlanner) planVolumes(ctx context.Context) error {
+	desired := make([]VolumeCreateOptions, len(p.manifest.Volumes))
+	for i, opts := range p.manifest.Volumes {
+		opts.Tags = p.withStackTags(opts.Tags)
+		desired[i] = opts
+	}
+
+	return planTopLevel(ctx, p, "volume", desired, p.client.ListVolumes,
+		func(live *Volume) (int, string, []string, VolumeUpdateOptions) {
+			return live.ID, live.Label, live.Tags, live.GetUpdateOptions()
+		},
+		func(opts VolumeCreateOptions) string { return opts.Label },
+		stackResourceHandlers[Volume, VolumeCreateOptions, VolumeUpdateOptions]{
+			create: func(ctx context.Context, client *Client, opts VolumeCreateOptions) error {
+				_, err := client.CreateVolume(ctx, opts)
+				return err
+			},
+			update: func(ctx context.Context, client *Client, id int, opts VolumeUpdateOptions) error {
+				_, err := client.UpdateVolume(ctx, id, opts)
+				return err
+			},
+			delete: deleteStackVolume,
+		})
+}
+
+// stackVolumeDetachTimeout is the maximum time to wait for a volume to be detached before it is deleted.
+const stackVolumeDetachTimeout = 5 * time.Minute
+
+// deleteStackVolume deletes the given volume, detaching it first if it is still attached,
+// as attached volumes cannot be deleted.
+func deleteStackVolume(client *Client, ctx context.Context, id int) error {
+	volume, err := client.GetVolume(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if volume.LinodeID != nil {
+		if err := client.DetachVolume(ctx, id); err != nil {
+			return fmt.Errorf("failed to detach volume %d: %w", id, err)
+		}
+
+		// Bound the wait so that a volume which fails to detach doesn't block the apply indefinitely
+		waitCtx, cancel := context.WithTimeout(ctx, stackVolumeDetachTimeout)
+		defer cancel()
+
+		if _, err := client.WaitForVolumeLinodeID(waitCtx, id, nil); err != nil {
+			return err
+		}
+	}
+
+	return client.DeleteVolume(ctx, id)
+}
+
+func (p *stackPlanner) planFirewalls(ctx context.Context) error {
+	desired := make([]FirewallCreateOptions, len(p.manifest.Firewalls))
+	for i, opts := range p.manifest.Firewalls {
+		opts.Tags = p.withStackTags(opts.Tags)
+		desired[i] = opts
+	}
+
+	return planTopLevel(ctx, p, "firewall", desired, p.client.ListFirewalls,
+		func(live *Firewall) (int, string, []string, FirewallUpdateOptions) {
+			return live.ID, live.Label, live.Tags, live.GetUpdateOptions()
+		},
+		func(opts FirewallCreateOptions) string { return opts.Label },
+		stackResourceHandlers[Firewall, FirewallCreateOptions, FirewallUpdateOptions]{
+			create: func(ctx context.Context, client *Client, opts FirewallCreateOptions) error {
+				_, err := client.CreateFirewall(ctx, opts)
+				return err
+			},
+			update: func(ctx context.Context, client *Client, id int, opts FirewallUpdateOptions) error {
+				_, err := client.UpdateFirewall(ctx, id, opts)
+				return err
+			},
+			delete:       (*Client).DeleteFirewall,
+			planChildren: p.planFirewallRules,
+		})
+}
+
+// planFirewallRules plans an update of an existing firewall's rules.
+func (p *stackPlanner) planFirewallRules(_ context.Context, live *Firewall, opts FirewallCreateOptions) error {
+	desired := FirewallRulesUpdateOptions(opts.Rules)
+	current := FirewallRulesUpdateOptions{
+		Inbound:        live.Rules.Inbound,
+		InboundPolicy:  live.Rules.InboundPolicy,
+		Outbound:       live.Rules.Outbound,
+		OutboundPolicy: live.Rules.OutboundPolicy,
+	}
+
+	fields, err := diffStackFields(current, desired)
+	if err != nil {
+		return err
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+
+	firewallID := live.ID
+
+	p.add(StackChange{
+		Action:       StackUpdate,
+		ResourceType: "firewall_rules",
+		Label:        live.Label,
+		ID:           firewallID,
+		Fields:       fields,
+		apply: func(ctx context.Context, client *Client) error {
+			_, err := client.UpdateFirewallRules(ctx, firewallID, desired)
+			return err
+		},
+	})
+
+	return nil
+}
+
+func (p *stackPlanner) planDomains(ctx context.Context) error {
+	desired := make([]StackManifestDomain, len(p.manifest.Domains))
+	for i, opts := range p.manifest.Domains {
+		opts.Tags = p.withStackTags(opts.Tags)
+		desired[i] = opts
+	}
+
+	return planTopLevel(ctx, p, "domain", desired, p.client.ListDomains,
+		func(live *Domain) (int, string, []string, DomainUpdateOptions) {
+			return live.ID, live.Domain, live.Tags, live.GetUpdateOptions()
+		},
+		func(opts StackManifestDomain) string { return opts.Domain },
+		stackResourceHandlers[Domain, StackManifestDomain, DomainUpdateOptions]{
+			create: func(ctx context.Context, client *Client, opts StackManifestDomain) error {
+				domain, err := client.CreateDomain(ctx, opts.DomainCreateOptions)
+				if err != nil {
+					return err
+				}
+
+				for _, record := range opts.Records {
+					if _, err := client.CreateDomainRecord(ctx, domain.ID, record); err != nil {
+						return fmt.Errorf("failed to create record %s: %w",
+							stackRecordKey(record.Type, record.Name, record.Target), err)
+					}
+				}
+
+				return nil
+			},
+			update: func(ctx context.Context, client *Client, id int, opts DomainUpdateOptions) error {
+				_, err := client.UpdateDomain(ctx, id, opts)
+				return err
+			},
+			delete:       (*Client).DeleteDomain,
+			planChildren: p.planDomainRecords,
+		})
+}
+
+// planDomainRecords plans the changes to the records of an existing domain.
+func (p *stackPlanner) planDomainRecords(ctx context.Context, live *Domain, opts StackManifestDomain) error {
+	records, err := p.client.ListDomainRecords(ctx, live.ID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list records of domain %s: %w", live.Domain, err)
+	}
+
+	domainID := live.ID
+
+	return planStackChildren(p, "domain_record", live.Domain, records, opts.Records,
+		func(record DomainRecord) (int, string, DomainRecordUpdateOptions) {
+			return record.ID, stackRecordKey(record.Type, record.Name, record.Target), record.GetUpdateOptions()
+		},
+		func(record DomainRecordCreateOptions) string {
+			return stackRecordKey(record.Type, record.Name, record.Target)
+		},
+		stackChildHandlers[DomainRecordCreateOptions, DomainRecordUpdateOptions]{
+			create: func(ctx context.Context, client *Client, opts DomainRecordCreateOptions) error {
+				_, err := client.CreateDomainRecord(ctx, domainID, opts)
+				return err
+			},
+			update: func(ctx context.Context, client *Client, id int, opts DomainRecordUpdateOptions) error {
+				_, err := client.UpdateDomainRecord(ctx, domainID, id, opts)
+				return err
+			},
+			delete: func(ctx context.Context, client *Client, id int) error {
+				return client.DeleteDomainRecord(ctx, domainID, id)
+			},
+		})
+}
+
+func (p *stackPlanner) planNodeBalancers(ctx context.Context) error {
+	desired := make([]NodeBalancerCreateOptions, len(p.manifest.NodeBalancers))
+	for i, opts := range p.manifest.NodeBalancers {
+		opts.Tags = p.withStackTags(opts.Tags)
+		desired[i] = opts
+	}
+
+	return planTopLevel(ctx, p, "nodebalancer", desired, p.client.ListNodeBalancers,
+		func(live *NodeBalancer) (int, string, []string, NodeBalancerUpdateOptions) {
+			label := ""
+			if live.Label != nil {
+				label = *live.Label
+			}
+
+			return live.ID, label, live.Tags, live.GetUpdateOptions()
+		},
+		func(opts NodeBalancerCreateOptions) string { return *opts.Label },
+		stackResourceHandlers[NodeBalancer, NodeBalancerCreateOptions, NodeBalancerUpdateOptions]{
+			create: func(ctx context.Context, client *Client, opts NodeBalancerCreateOptions) error {
+				_, err := client.CreateNodeBalancer(ctx, opts)
+				return err
+			},
+			update: func(ctx context.Context, client *Client, id int, opts NodeBalancerUpdateOptions) error {
+				_, err := client.UpdateNodeBalancer(ctx, id, opts)
+				return err
+			},
+			delete:       (*Client).DeleteNodeBalancer,
+			planChildren: p.planNodeBalancerConfigs,
+		})
+}
+
+// planNodeBalancerConfigs plans the changes to the configs of an existing NodeBalancer,
+// matched by port, and to the nodes of its existing configs, matched by address.
+func (p *stackPlanner) planNodeBalancerConfigs(
+	ctx context.Context,
+	live *NodeBalancer,
+	opts NodeBalancerCreateOptions,
+) error {
+	configs, err := p.client.ListNodeBalancerConfigs(ctx, live.ID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list configs of NodeBalancer %d: %w", live.ID, err)
+	}
+
+	nodeBalancerID := live.ID
+	label := *opts.Label
+
+	err = planStackChildren(p, "nodebalancer_config", label, configs, opts.Configs,
+		func(config NodeBalancerConfig) (int, string, NodeBalancerConfigUpdateOptions) {
+			return config.ID, fmt.Sprint(config.Port), config.GetUpdateOptions()
+		},
+		func(config NodeBalancerConfigCreateOptions) string { return fmt.Sprint(config.Port) },
+		stackChildHandlers[NodeBalancerConfigCreateOptions, NodeBalancerConfigUpdateOptions]{
+			create: func(ctx context.Context, client *Client, opts NodeBalancerConfigCreateOptions) error {
+				_, err := client.CreateNodeBalancerConfig(ctx, nodeBalancerID, opts)
+				return err
+			},
+			update: func(ctx context.Context, client *Client, id int, opts NodeBalancerConfigUpdateOptions) error {
+				// Nodes are managed separately
+				opts.Nodes = nil
+
+				_, err := client.UpdateNodeBalancerConfig(ctx, nodeBalancerID, id, opts)
+
+				return err
+			},
+			delete: func(ctx context.Context, client *Client, id int) error {
+				return client.DeleteNodeBalancerConfig(ctx, nodeBalancerID, id)
+			},
+			// The SSL key is write-only and the nodes are compared separately
+			ignoreFields: []string{"nodes", "ssl_cert", "ssl_key"},
+		})
+	if err != nil {
+		return err
+	}
+
+	for _, config := range configs {
+		index := slices.IndexFunc(opts.Configs, func(desired NodeBalancerConfigCreateOptions) bool {
+			return desired.Port == config.Port
+		})
+		if index < 0 {
+			continue
+		}
+
+		if err := p.planNodeBalancerNodes(ctx, nodeBalancerID, label, config, opts.Configs[index].Nodes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *stackPlanner) planNodeBalancerNodes(
+	ctx context.Context,
+	nodeBalancerID int,
+	nodeBalancerLabel string,
+	config NodeBalancerConfig,
+	desired []NodeBalancerNodeCreateOptions,
+) error {
+	nodes, err := p.client.ListNodeBalancerNodes(ctx, nodeBalancerID, config.ID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list nodes of NodeBalancer %d config %d: %w", nodeBalancerID, config.ID, err)
+	}
+
+	configID := config.ID
+
+	return planStackChildren(p, "nodebalancer_node", fmt.Sprintf("%s:%d", nodeBalancerLabel, config.Port),
+		nodes, desired,
+		func(node NodeBalancerNode) (int, string, NodeBalancerNodeUpdateOptions) {
+			return node.ID, node.Address, node.GetUpdateOptions()
+		},
+		func(node NodeBalancerNodeCreateOptions) string { return node.Address },
+		stackChildHandlers[NodeBalancerNodeCreateOptions, NodeBalancerNodeUpdateOptions]{
+			create: func(ctx context.Context, client *Client, opts NodeBalancerNodeCreateOptions) error {
+				_, err := client.CreateNodeBalancerNode(ctx, nodeBalancerID, configID, opts)
+				return err
+			},
+			update: func(ctx context.Context, client *Client, id int, opts NodeBalancerNodeUpdateOptions) error {
+				_, err := client.UpdateNodeBalancerNode(ctx, nodeBalancerID, configID, id, opts)
+				return err
+			},
+			delete: func(ctx context.Context, client *Client, id int) error {
+				return client.DeleteNodeBalancerNode(ctx, nodeBalancerID, configID, id)
+			},
+		})
+}
+
+// stackChildHandlers describes how to change the children of an existing resource.
+type stackChildHandlers[C, U any] struct {
+	create       func(ctx context.Context, client *Client, opts C) error
+	update       func(ctx context.Context, client *Client, id int, opts U) error
+	delete       func(ctx context.Context, client *Client, id int) error
+	ignoreFields []string
+}
+
+// planStackChildren plans the changes to the children of an existing resource.
+// Unlike top-level resources, children which are not in the manifest are always deleted.
+func planStackChildren[T, C, U any](
+	p *stackPlanner,
+	resourceType string,
+	parentLabel string,
+	live []T,
+	desired []C,
+	describe func(live T) (id int, key string, updateOpts U),
+	describeDesired func(opts C) string,
+	handle stackChildHandlers[C, U],
+) error {
+	byKey := make(map[string]T, len(live))
+
+	for _, item := range live {
+		_, key, _ := describe(item)
+		byKey[key] = item
+	}
+
+	desiredKeys := make(map[string]struct{}, len(desired))
+
+	for _, opts := range desired {
+		key := describeDesired(opts)
+		desiredKeys[key] = struct{}{}
+		label := parentLabel + " " + key
+
+		current, ok := byKey[key]
+		if !ok {
+			p.add(StackChange{
+				Action:       StackCreate,
+				ResourceType: resourceType,
+				Label:        label,
+				apply: func(ctx context.Context, client *Client) error {
+					return handle.create(ctx, client, opts)
+				},
+			})
+
+			continue
+		}
+
+		id, _, liveUpdateOpts := describe(current)
+
+		desiredUpdateOpts, err := convertStackOptions[U](opts)
+		if err != nil {
+			return err
+		}
+
+		fields, err := diffStackFields(liveUpdateOpts, desiredUpdateOpts, handle.ignoreFields...)
+		if err != nil {
+			return err
+		}
+
+		if len(fields) == 0 {
+			continue
+		}
+
+		p.add(StackChange{
+			Action:       StackUpdate,
+			ResourceType: resourceType,
+			Label:        label,
+			ID:           id,
+			Fields:       fields,
+			apply: func(ctx context.Context, client *Client) error {
+				return handle.update(ctx, client, id, desiredUpdateOpts)
+			},
+		})
+	}
+
+	for _, item := range live {
+		id, key, _ := describe(item)
+
+		if _, ok := desiredKeys[key]; ok {
+			continue
+		}
+
+		p.add(StackChange{
+			Action:       StackDelete,
+			ResourceType: resourceType,
+			Label:        parentLabel + " " + key,
+			ID:           id,
+			apply: func(ctx context.Context, client *Client) error {
+				return handle.delete(ctx, client, id)
+			},
+		})
+	}
+
+	return nil
+}
+
+func stackRecordKey(recordType DomainRecordType, name, target string) string {
+	return fmt.Sprintf("%s %s %s", recordType, name, target)
+}
+
+// convertStackOptions converts create options to the corresponding update options
+// by their JSON representation, dropping any fields which cannot be updated.
+func convertStackOptions[U any](opts any) (U, error) {
+	var result U
+
+	contents, err := json.Marshal(opts)
+	if err != nil {
+		return result, fmt.Errorf("failed to convert %T: %w", opts, err)
+	}
+
+	if err := json.Unmarshal(contents, &result); err != nil {
+		return result, fmt.Errorf("failed to convert %T to %T: %w", opts, result, err)
+	}
+
+	return result, nil
+}
+
+// diffStackFields compares the fields set in the desired update options with the live
+// resource's update options, returning the fields which differ by their JSON names.
+func diffStackFields(live, desired any, ignoreFields ...string) ([]StackFieldChange, error) {
+	liveFields, err := stackFieldMap(live)
+	if err != nil {
+		return nil, err
+	}
+
+	desiredFields, err := stackFieldMap(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]StackFieldChange, 0)
+
+	for _, field := range slices.Sorted(maps.Keys(desiredFields)) {
+		value := desiredFields[field]
+
+		// Unset fields are left unchanged
+		if value == nil || slices.Contains(ignoreFields, field) {
+			continue
+		}
+
+		if !stackValuesEqual(field, liveFields[field], value) {
+			result = append(result, StackFieldChange{Field: field, Old: liveFields[field], New: value})
+		}
+	}
+
+	return result, nil
+}
+
+func stackFieldMap(value any) (map[string]any, error) {
+	contents, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare %T: %w", value, err)
+	}
+
+	var result map[string]any
+	if err := json.Unmarshal(contents, &result); err != nil {
+		return nil, fmt.Errorf("failed to compare %T: %w", value, err)
+	}
+
+	return result, nil
+}
+
+func stackValuesEqual(field string, live, desired any) bool {
+	liveList, liveIsList := live.([]any)
+	desiredList, desiredIsList := desired.([]any)
+
+	// Treat missing lists as empty
+	if live == nil && desiredIsList {
+		return len(desiredList) == 0
+	}
+
+	// Tags are unordered
+	if field == "tags" && liveIsList && desiredIsList {
+		return reflect.DeepEqual(sortedStackValues(liveList), sortedStackValues(desiredList))
+	}
+
+	return reflect.DeepEqual(live, desired)
+}
+
+func sortedStackValues(values []any) []string {
+	result := make([]string, len(values))
+	for i, value := range values {
+		result[i] = fmt.Sprint(value)
+	}
+
+	slices.Sort(result)
+
+	return result
+}
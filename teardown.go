@@ -0,0 +1,552 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+// TeardownAction is the kind of operation performed by a TeardownStep.
+type TeardownAction string
+
+// TeardownAction enums represent the kinds of operations performed by a TeardownStep.
+const (
+	TeardownDetach       TeardownAction = "detach"
+	TeardownRemoveDevice TeardownAction = "remove_device"
+	TeardownDelete       TeardownAction = "delete"
+)
+
+// TeardownStep is an operation in a TeardownPlan.
+type TeardownStep struct {
+	// Key identifies the step in the plan and in the report returned when applying it,
+	// e.g. "delete linode 123".
+	Key string
+
+	Action     TeardownAction
+	EntityType EntityType
+	ID         string
+	Label      string
+
+	// DependsOn lists the keys of the steps which must succeed before this step is run.
+	DependsOn []string
+
+	run func(ctx context.Context, client *Client) error
+}
+
+// String returns a human-readable description of the step.
+func (s TeardownStep) String() string {
+	return fmt.Sprintf("%s %s %s (%s)", s.Action, s.EntityType, s.Label, s.ID)
+}
+
+// TeardownPlan is the ordered set of steps needed to remove all resources with a tag.
+type TeardownPlan struct {
+	Tag   string
+	Steps []TeardownStep
+}
+
+// Empty returns whether the plan has no steps.
+func (p *TeardownPlan) Empty() bool {
+	return len(p.Steps) == 0
+}
+
+// TeardownOptions configures TeardownTag(...).
+type TeardownOptions struct {
+	// DryRun returns the plan without running any of its steps.
+	DryRun bool
+
+	// Concurrency is the maximum number of steps run concurrently.
+	// Defaults to DefaultBatchConcurrency.
+	Concurrency int
+
+	// OnResult is called with the result of each step as it settles.
+	OnResult func(result BatchResult)
+}
+
+// TeardownTag removes all resources with the given tag, e.g. those left behind by a crashed
+// test run. See PlanTeardown(...) for the resources which are removed.
+//
+// If opts.DryRun is set, the plan is returned without running it and the report is nil.
+// Otherwise, failed steps are reported rather than returned as an error, and the steps
+// depending on them are skipped; use the report's Err() method to check for failures.
+func (c *Client) TeardownTag(ctx context.Context, tag string, opts *TeardownOptions) (*TeardownPlan, *BatchReport, error) {
+	if opts == nil {
+		opts = &TeardownOptions{}
+	}
+
+	plan, err := c.PlanTeardown(ctx, tag)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opts.DryRun {
+		return plan, nil, nil
+	}
+
+	report, err := c.ApplyTeardownPlan(ctx, plan, &BatchOptions{
+		Concurrency: opts.Concurrency,
+		OnResult:    opts.OnResult,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return plan, report, nil
+}
+
+// PlanTeardown returns the steps needed to remove all instances, volumes, NodeBalancers,
+// LKE clusters, domains, reserved IP addresses and firewalls with the given tag.
+//
+// Steps are ordered by their dependencies: tagged volumes are detached before their instances
+// are deleted, firewall devices are removed before the firewalls and devices are deleted,
+// NodeBalancer configs are deleted before their NodeBalancers, and LKE clusters are deleted
+// before any firewalls, as their node pools may reference them. Instances managed by LKE are
+// left to their clusters. Tagged reserved IP addresses are deleted after their instances, or
+// unassigned first if their instances aren't part of the plan.
+func (c *Client) PlanTeardown(ctx context.Context, tag string) (*TeardownPlan, error) {
+	planner := &teardownPlanner{
+		client: c,
+		plan:   &TeardownPlan{Tag: tag, Steps: make([]TeardownStep, 0)},
+		keys:   make(map[string]int),
+	}
+
+	if err := planner.collect(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, plan := range []func(ctx context.Context) error{
+		planner.planLKEClusters,
+		planner.planVolumeDetaches,
+		planner.planFirewallDevices,
+		planner.planNodeBalancers,
+		planner.planInstances,
+		planner.planVolumes,
+		planner.planFirewalls,
+		planner.planDomains,
+		planner.planReservedIPs,
+	} {
+		if err := plan(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return planner.plan, nil
+}
+
+// ApplyTeardownPlan runs the steps of the given plan, respecting their dependencies,
+// and returns a report of their outcomes. Deleting a resource which no longer exists
+// is considered successful.
+func (c *Client) ApplyTeardownPlan(ctx context.Context, plan *TeardownPlan, opts *BatchOptions) (*BatchReport, error) {
+	operations := make([]BatchOperation, len(plan.Steps))
+
+	for i, step := range plan.Steps {
+		operations[i] = BatchOperation{
+			Key:       step.Key,
+			DependsOn: step.DependsOn,
+			Run: func(ctx context.Context, client *Client) error {
+				if err := step.run(ctx, client); err != nil && !IsNotFound(err) {
+					return err
+				}
+
+				return nil
+			},
+		}
+	}
+
+	return c.RunBatch(ctx, operations, opts)
+}
+
+type teardownPlanner struct {
+	client *Client
+	plan   *TeardownPlan
+
+	// keys maps step keys to their index in the plan
+	keys map[string]int
+
+	instances     []Instance
+	volumes       []Volume
+	nodebalancers []NodeBalancer
+	clusters      []LKECluster
+	domains       []Domain
+	reservedIPs   []InstanceIP
+	firewalls     []Firewall
+
+	// devices maps firewall IDs to their devices
+	devices map[int][]FirewallDevice
+}
+
+// collect finds the resources with the planner's tag.
+func (p *teardownPlanner) collect(ctx context.Context) error {
+	objects, err := p.client.ListTaggedObjects(ctx, p.plan.Tag, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list objects tagged %s: %w", p.plan.Tag, err)
+	}
+
+	for _, object := range objects {
+		switch data := object.Data.(type) {
+		case Instance:
+			p.instances = append(p.instances, data)
+		case Volume:
+			p.volumes = append(p.volumes, data)
+		case NodeBalancer:
+			p.nodebalancers = append(p.nodebalancers, data)
+		case LKECluster:
+			p.clusters = append(p.clusters, data)
+		case Domain:
+			p.domains = append(p.domains, data)
+		case InstanceIP:
+			p.reservedIPs = append(p.reservedIPs, data)
+		}
+	}
+
+	// Firewalls aren't returned by the tags endpoint
+	firewalls, err := p.client.ListFirewalls(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list firewalls: %w", err)
+	}
+
+	p.devices = make(map[int][]FirewallDevice)
+
+	for _, firewall := range firewalls {
+		if !slices.Contains(firewall.Tags, p.plan.Tag) {
+			continue
+		}
+
+		p.firewalls = append(p.firewalls, firewall)
+
+		p.devices[firewall.ID], err = p.client.ListFirewallDevices(ctx, firewall.ID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list devices of firewall %d: %w", firewall.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func teardownKey(action TeardownAction, entityType EntityType, id string) string {
+	return fmt.Sprintf("%s %s %s", action, entityType, id)
+}
+
+func (p *teardownPlanner) add(step TeardownStep) {
+	step.Key = teardownKey(step.Action, step.EntityType, step.ID)
+
+	// Only depend on steps which are part of the plan
+	dependencies := make([]string, 0, len(step.DependsOn))
+
+	for _, dependency := range step.DependsOn {
+		if _, ok := p.keys[dependency]; ok && !slices.Contains(dependencies, dependency) {
+			dependencies = append(dependencies, dependency)
+		}
+	}
+
+	step.DependsOn = dependencies
+
+	p.keys[step.Key] = len(p.plan.Steps)
+	p.plan.Steps = append(p.plan.Steps, step)
+}
+
+// stepsWith returns the keys of the planned steps with the given action and entity type.
+func (p *teardownPlanner) stepsWith(action TeardownAction, entityType EntityType) []string {
+	result := make([]string, 0)
+
+	for _, step := range p.plan.Steps {
+		if step.Action == action && step.EntityType == entityType {
+			result = append(result, step.Key)
+		}
+	}
+
+	return result
+}
+
+// deviceRemovals returns the keys of the planned steps removing the given entity from firewalls.
+func (p *teardownPlanner) deviceRemovals(entityType FirewallDeviceType, entityID int) []string {
+	result := make([]string, 0)
+
+	for _, firewall := range p.firewalls {
+		for _, device := range p.devices[firewall.ID] {
+			if device.Entity.Type == entityType && device.Entity.ID == entityID {
+				result = append(result, teardownKey(TeardownRemoveDevice, EntityFirewall,
+					fmt.Sprintf("%d/%d", firewall.ID, device.ID)))
+			}
+		}
+	}
+
+	return result
+}
+
+func (p *teardownPlanner) planLKEClusters(_ context.Context) error {
+	for _, cluster := range p.clusters {
+		p.add(TeardownStep{
+			Action:     TeardownDelete,
+			EntityType: EntityLKECluster,
+			ID:         strconv.Itoa(cluster.ID),
+			Label:      cluster.Label,
+			run: func(ctx context.Context, client *Client) error {
+				if err := client.DeleteLKECluster(ctx, cluster.ID); err != nil {
+					return err
+				}
+
+				// Wait for the cluster to be removed, so that its firewalls can be deleted
+				return waitForGone(ctx, client, func(ctx context.Context) (*LKECluster, error) {
+					return client.GetLKECluster(ctx, cluster.ID)
+				})
+			},
+		})
+	}
+
+	return nil
+}
+
+func (p *teardownPlanner) planVolumeDetaches(_ context.Context) error {
+	for _, volume := range p.volumes {
+		if volume.LinodeID == nil {
+			continue
+		}
+
+		p.add(TeardownStep{
+			Action:     TeardownDetach,
+			EntityType: EntityVolume,
+			ID:         strconv.Itoa(volume.ID),
+			Label:      volume.Label,
+			run: func(ctx context.Context, client *Client) error {
+				if err := client.WaitForResourceFree(ctx, EntityVolume, volume.ID); err != nil {
+					return err
+				}
+
+				if err := client.DetachVolume(ctx, volume.ID); err != nil {
+					return err
+				}
+
+				_, err := client.WaitForVolumeLinodeID(ctx, volume.ID, nil)
+
+				return err
+			},
+		})
+	}
+
+	return nil
+}
+
+func (p *teardownPlanner) planFirewallDevices(_ context.Context) error {
+	for _, firewall := range p.firewalls {
+		for _, device := range p.devices[firewall.ID] {
+			label := strconv.Itoa(device.Entity.ID)
+			if device.Entity.Label != nil {
+				label = *device.Entity.Label
+			}
+
+			p.add(TeardownStep{
+				Action:     TeardownRemoveDevice,
+				EntityType: EntityFirewall,
+				ID:         fmt.Sprintf("%d/%d", firewall.ID, device.ID),
+				Label:      fmt.Sprintf("%s from %s", label, firewall.Label),
+				run: func(ctx context.Context, client *Client) error {
+					return client.DeleteFirewallDevice(ctx, firewall.ID, device.ID)
+				},
+			})
+		}
+	}
+
+	return nil
+}
+
+func (p *teardownPlanner) planNodeBalancers(ctx context.Context) error {
+	for _, nodebalancer := range p.nodebalancers {
+		configs, err := p.client.ListNodeBalancerConfigs(ctx, nodebalancer.ID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list configs of NodeBalancer %d: %w", nodebalancer.ID, err)
+		}
+
+		label := ""
+		if nodebalancer.Label != nil {
+			label = *nodebalancer.Label
+		}
+
+		dependencies := p.deviceRemovals(FirewallDeviceNodeBalancer, nodebalancer.ID)
+
+		for _, config := range configs {
+			step := TeardownStep{
+				Action:     TeardownDelete,
+				EntityType: EntityNodebalancerConfig,
+				ID:         fmt.Sprintf("%d/%d", nodebalancer.ID, config.ID),
+				Label:      fmt.Sprintf("%s port %d", label, config.Port),
+				run: func(ctx context.Context, client *Client) error {
+					return client.DeleteNodeBalancerConfig(ctx, nodebalancer.ID, config.ID)
+				},
+			}
+
+			p.add(step)
+			dependencies = append(dependencies, teardownKey(step.Action, step.EntityType, step.ID))
+		}
+
+		p.add(TeardownStep{
+			Action:     TeardownDelete,
+			EntityType: EntityNodebalancer,
+			ID:         strconv.Itoa(nodebalancer.ID),
+			Label:      label,
+			DependsOn:  dependencies,
+			run: func(ctx context.Context, client *Client) error {
+				return client.DeleteNodeBalancer(ctx, nodebalancer.ID)
+			},
+		})
+	}
+
+	return nil
+}
+
+func (p *teardownPlanner) planInstances(_ context.Context) error {
+	for _, instance := range p.instances {
+		if instance.LKEClusterID != 0 {
+			continue
+		}
+
+		dependencies := p.deviceRemovals(FirewallDeviceLinode, instance.ID)
+
+		for _, volume := range p.volumes {
+			if volume.LinodeID != nil && *volume.LinodeID == instance.ID {
+				dependencies = append(dependencies, teardownKey(TeardownDetach, EntityVolume, strconv.Itoa(volume.ID)))
+			}
+		}
+
+		p.add(TeardownStep{
+			Action:     TeardownDelete,
+			EntityType: EntityLinode,
+			ID:         strconv.Itoa(instance.ID),
+			Label:      instance.Label,
+			DependsOn:  dependencies,
+			run: func(ctx context.Context, client *Client) error {
+				if err := client.WaitForResourceFree(ctx, EntityLinode, instance.ID); err != nil {
+					return err
+				}
+
+				return client.DeleteInstance(ctx, instance.ID)
+			},
+		})
+	}
+
+	return nil
+}
+
+func (p *teardownPlanner) planVolumes(_ context.Context) error {
+	for _, volume := range p.volumes {
+		p.add(TeardownStep{
+			Action:     TeardownDelete,
+			EntityType: EntityVolume,
+			ID:         strconv.Itoa(volume.ID),
+			Label:      volume.Label,
+			DependsOn:  []string{teardownKey(TeardownDetach, EntityVolume, strconv.Itoa(volume.ID))},
+			run: func(ctx context.Context, client *Client) error {
+				if err := client.WaitForResourceFree(ctx, EntityVolume, volume.ID); err != nil {
+					return err
+				}
+
+				return client.DeleteVolume(ctx, volume.ID)
+			},
+		})
+	}
+
+	return nil
+}
+
+func (p *teardownPlanner) planFirewalls(_ context.Context) error {
+	clusterDeletes := p.stepsWith(TeardownDelete, EntityLKECluster)
+
+	for _, firewall := range p.firewalls {
+		dependencies := slices.Clone(clusterDeletes)
+
+		for _, device := range p.devices[firewall.ID] {
+			dependencies = append(dependencies, teardownKey(TeardownRemoveDevice, EntityFirewall,
+				fmt.Sprintf("%d/%d", firewall.ID, device.ID)))
+		}
+
+		p.add(TeardownStep{
+			Action:     TeardownDelete,
+			EntityType: EntityFirewall,
+			ID:         strconv.Itoa(firewall.ID),
+			Label:      firewall.Label,
+			DependsOn:  dependencies,
+			run: func(ctx context.Context, client *Client) error {
+				return client.DeleteFirewall(ctx, firewall.ID)
+			},
+		})
+	}
+
+	return nil
+}
+
+func (p *teardownPlanner) planDomains(_ context.Context) error {
+	for _, domain := range p.domains {
+		p.add(TeardownStep{
+			Action:     TeardownDelete,
+			EntityType: EntityDomain,
+			ID:         strconv.Itoa(domain.ID),
+			Label:      domain.Domain,
+			run: func(ctx context.Context, client *Client) error {
+				return client.DeleteDomain(ctx, domain.ID)
+			},
+		})
+	}
+
+	return nil
+}
+
+func (p *teardownPlanner) planReservedIPs(_ context.Context) error {
+	for _, ip := range p.reservedIPs {
+		dependencies := make([]string, 0)
+
+		if ip.LinodeID != 0 {
+			instanceKey := teardownKey(TeardownDelete, EntityLinode, strconv.Itoa(ip.LinodeID))
+
+			// Reserved IPs can't be deleted while they're assigned to an instance,
+			// so unassign them from instances which aren't being deleted
+			if _, ok := p.keys[instanceKey]; !ok {
+				p.add(TeardownStep{
+					Action:     TeardownDetach,
+					EntityType: EntityIPAddress,
+					ID:         ip.Address,
+					Label:      ip.Address,
+					run: func(ctx context.Context, client *Client) error {
+						return client.DeleteInstanceIPAddress(ctx, ip.LinodeID, ip.Address)
+					},
+				})
+			}
+
+			dependencies = append(dependencies,
+				instanceKey,
+				teardownKey(TeardownDetach, EntityIPAddress, ip.Address),
+			)
+		}
+
+		p.add(TeardownStep{
+			Action:     TeardownDelete,
+			EntityType: EntityIPAddress,
+			ID:         ip.Address,
+			Label:      ip.Address,
+			DependsOn:  dependencies,
+			run: func(ctx context.Context, client *Client) error {
+				return client.DeleteReservedIPAddress(ctx, ip.Address)
+			},
+		})
+	}
+
+	return nil
+}
+
+// waitForGone polls get until it returns a 404 error.
+func waitForGone[T any](ctx context.Context, client *Client, get func(ctx context.Context) (T, error)) error {
+	_, err := waitFor(ctx, client, get,
+		func(T) (bool, error) {
+			return false, nil
+		},
+		nil,
+		func(err error) error {
+			return fmt.Errorf("failed to wait for resource to be deleted: %w", err)
+		},
+	)
+
+	if IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,852 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// terraformResourceTypes lists the Terraform resource types supported by ExportTerraform(...),
+// in the order they are exported.
+var terraformResourceTypes = []string{
+	"linode_vpc",
+	"linode_vpc_subnet",
+	"linode_instance",
+	"linode_instance_disk",
+	"linode_instance_config",
+	"linode_volume",
+	"linode_nodebalancer",
+	"linode_nodebalancer_config",
+	"linode_nodebalancer_node",
+	"linode_firewall",
+	"linode_domain",
+	"linode_domain_record",
+	"linode_lke_cluster",
+	"linode_object_storage_bucket",
+	"linode_database_mysql_v2",
+	"linode_database_postgresql_v2",
+}
+
+// TerraformExportOptions configures ExportTerraform(...).
+type TerraformExportOptions struct {
+	// ResourceTypes restricts the export to the given Terraform resource types,
+	// e.g. "linode_instance" or "linode_domain_record". Defaults to all supported types.
+	ResourceTypes []string
+}
+
+// ExportTerraform writes the account's resources to w as Terraform configuration for the
+// linode provider, with an import block for each resource so that the configuration can be
+// adopted using `terraform plan` without recreating anything.
+//
+// Resources reference each other by address rather than by literal ID where possible,
+// e.g. a volume attached to an exported instance uses `linode_id = linode_instance.web.id`.
+// Instances managed by LKE node pools are not exported, as they are managed by their cluster.
+//
+// The exported configuration should be reviewed before applying, as some arguments,
+// e.g. root passwords and TLS private keys, can't be read back from the API.
+func (c *Client) ExportTerraform(ctx context.Context, w io.Writer, opts *TerraformExportOptions) error {
+	exporter := &terraformExporter{
+		client:    c,
+		types:     make(map[string]bool),
+		names:     make(map[string]struct{}),
+		addresses: make(map[string]string),
+	}
+
+	if opts != nil {
+		for _, resourceType := range opts.ResourceTypes {
+			if !slices.Contains(terraformResourceTypes, resourceType) {
+				return fmt.Errorf("unsupported Terraform resource type %s", resourceType)
+			}
+
+			exporter.types[resourceType] = true
+		}
+	}
+
+	if len(exporter.types) == 0 {
+		for _, resourceType := range terraformResourceTypes {
+			exporter.types[resourceType] = true
+		}
+	}
+
+	for _, collect := range []func(ctx context.Context) error{
+		exporter.collectVPCs,
+		exporter.collectInstances,
+		exporter.collectVolumes,
+		exporter.collectNodeBalancers,
+		exporter.collectFirewalls,
+		exporter.collectDomains,
+		exporter.collectLKEClusters,
+		exporter.collectBuckets,
+		exporter.collectDatabases,
+	} {
+		if err := collect(ctx); err != nil {
+			return err
+		}
+	}
+
+	var builder strings.Builder
+
+	for i, resource := range exporter.resources {
+		if i > 0 {
+			builder.WriteString("\n")
+		}
+
+		body := &hclBody{}
+		resource.render(body)
+
+		fmt.Fprintf(&builder, "resource %s %s {\n", hclQuote(resource.resourceType), hclQuote(resource.name))
+		body.write(&builder, 1)
+		builder.WriteString("}\n\n")
+
+		importBody := &hclBody{}
+		importBody.set("to", hclRef(resource.address()))
+		importBody.set("id", resource.importID)
+
+		builder.WriteString("import {\n")
+		importBody.write(&builder, 1)
+		builder.WriteString("}\n")
+	}
+
+	if _, err := io.WriteString(w, builder.String()); err != nil {
+		return fmt.Errorf("failed to write Terraform configuration: %w", err)
+	}
+
+	return nil
+}
+
+type terraformResource struct {
+	resourceType string
+	name         string
+	importID     string
+	render       func(body *hclBody)
+}
+
+func (r *terraformResource) address() string {
+	return r.resourceType + "." + r.name
+}
+
+type terraformExporter struct {
+	client    *Client
+	types     map[string]bool
+	resources []*terraformResource
+
+	// names contains the addresses of all exported resources, used to deduplicate names
+	names map[string]struct{}
+
+	// addresses maps resource types and import IDs to the addresses of the exported resources
+	addresses map[string]string
+}
+
+// wants returns whether any of the given resource types should be exported.
+func (e *terraformExporter) wants(resourceTypes ...string) bool {
+	return slices.ContainsFunc(resourceTypes, func(resourceType string) bool {
+		return e.types[resourceType]
+	})
+}
+
+// add registers a resource to be exported if its type was requested. The render function
+// is called once all resources have been registered, so that it can reference any of them
+// regardless of the order they were collected in.
+func (e *terraformExporter) add(resourceType, label, importID string, render func(body *hclBody)) {
+	if !e.types[resourceType] {
+		return
+	}
+
+	resource := &terraformResource{
+		resourceType: resourceType,
+		name:         terraformName(label),
+		importID:     importID,
+		render:       render,
+	}
+
+	base := resource.name
+
+	for i := 2; ; i++ {
+		if _, ok := e.names[resource.address()]; !ok {
+			break
+		}
+
+		resource.name = fmt.Sprintf("%s_%d", base, i)
+	}
+
+	e.names[resource.address()] = struct{}{}
+	e.addresses[resourceType+":"+importID] = resource.address()
+	e.resources = append(e.resources, resource)
+}
+
+// ref returns a reference to the ID of the exported resource with the given type and import ID,
+// or the given literal ID if the resource isn't exported.
+func (e *terraformExporter) ref(resourceType, importID string, id int) any {
+	if address, ok := e.addresses[resourceType+":"+importID]; ok {
+		return hclRef(address + ".id")
+	}
+
+	return id
+}
+
+func (e *terraformExporter) collectVPCs(ctx context.Context) error {
+	if !e.wants("linode_vpc", "linode_vpc_subnet") {
+		return nil
+	}
+
+	vpcs, err := e.client.ListVPCs(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list VPCs: %w", err)
+	}
+
+	for _, vpc := range vpcs {
+		e.add("linode_vpc", vpc.Label, strconv.Itoa(vpc.ID), func(body *hclBody) {
+			body.set("label", vpc.Label)
+			body.set("region", vpc.Region)
+			body.setOptional("description", vpc.Description)
+		})
+
+		for _, subnet := range vpc.Subnets {
+			e.add("linode_vpc_subnet", vpc.Label+"_"+subnet.Label, fmt.Sprintf("%d,%d", vpc.ID, subnet.ID),
+				func(body *hclBody) {
+					body.set("vpc_id", e.ref("linode_vpc", strconv.Itoa(vpc.ID), vpc.ID))
+					body.set("label", subnet.Label)
+					body.setOptional("ipv4", subnet.IPv4)
+				})
+		}
+	}
+
+	return nil
+}
+
+func (e *terraformExporter) collectInstances(ctx context.Context) error {
+	if !e.wants("linode_instance", "linode_instance_disk", "linode_instance_config") {
+		return nil
+	}
+
+	instances, err := e.client.ListInstances(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list instances: %w", err)
+	}
+
+	for _, instance := range instances {
+		if instance.LKEClusterID != 0 {
+			continue
+		}
+
+		e.add("linode_instance", instance.Label, strconv.Itoa(instance.ID), func(body *hclBody) {
+			body.set("label", instance.Label)
+			body.set("region", instance.Region)
+			body.set("type", instance.Type)
+			body.setOptional("tags", instance.Tags)
+			body.setOptional("backups_enabled", instance.Backups != nil && instance.Backups.Enabled)
+		})
+
+		if e.wants("linode_instance_disk") {
+			disks, err := e.client.ListInstanceDisks(ctx, instance.ID, nil)
+			if err != nil {
+				return fmt.Errorf("failed to list disks of instance %d: %w", instance.ID, err)
+			}
+
+			for _, disk := range disks {
+				e.add("linode_instance_disk", instance.Label+"_"+disk.Label,
+					fmt.Sprintf("%d,%d", instance.ID, disk.ID), func(body *hclBody) {
+						body.set("linode_id", e.ref("linode_instance", strconv.Itoa(instance.ID), instance.ID))
+						body.set("label", disk.Label)
+						body.set("size", disk.Size)
+						body.setOptional("filesystem", disk.Filesystem)
+					})
+			}
+		}
+
+		if e.wants("linode_instance_config") {
+			configs, err := e.client.ListInstanceConfigs(ctx, instance.ID, nil)
+			if err != nil {
+				return fmt.Errorf("failed to list configs of instance %d: %w", instance.ID, err)
+			}
+
+			for _, config := range configs {
+				e.addInstanceConfig(instance, config)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (e *terraformExporter) addInstanceConfig(instance Instance, config InstanceConfig) {
+	e.add("linode_instance_config", instance.Label+"_"+config.Label,
+		fmt.Sprintf("%d,%d", instance.ID, config.ID), func(body *hclBody) {
+			body.set("linode_id", e.ref("linode_instance", strconv.Itoa(instance.ID), instance.ID))
+			body.set("label", config.Label)
+			body.setOptional("comments", config.Comments)
+			body.setOptional("kernel", config.Kernel)
+			body.setOptional("root_device", config.RootDevice)
+			body.setOptional("run_level", config.RunLevel)
+			body.setOptional("virt_mode", config.VirtMode)
+			body.setOptional("memory_limit", config.MemoryLimit)
+
+			for _, device := range instanceConfigDevices(config.Devices) {
+				block := body.block("device")
+				block.set("device_name", device.name)
+
+				if device.DiskID != 0 {
+					block.set("disk_id", e.ref("linode_instance_disk",
+						fmt.Sprintf("%d,%d", instance.ID, device.DiskID), device.DiskID))
+				}
+
+				if device.VolumeID != 0 {
+					block.set("volume_id", e.ref("linode_volume", strconv.Itoa(device.VolumeID), device.VolumeID))
+				}
+			}
+
+			for _, iface := range config.Interfaces {
+				block := body.block("interface")
+				block.set("purpose", iface.Purpose)
+				block.setOptional("label", iface.Label)
+				block.setOptional("ipam_address", iface.IPAMAddress)
+
+				if iface.VPCID != nil && iface.SubnetID != nil {
+					block.set("subnet_id", e.ref("linode_vpc_subnet",
+						fmt.Sprintf("%d,%d", *iface.VPCID, *iface.SubnetID), *iface.SubnetID))
+				}
+			}
+		})
+}
+
+type terraformConfigDevice struct {
+	name string
+	InstanceConfigDevice
+}
+
+// instanceConfigDevices returns the devices in the given device map ordered by device name.
+func instanceConfigDevices(devices *InstanceConfigDeviceMap) []terraformConfigDevice {
+	if devices == nil {
+		return nil
+	}
+
+	// Round trip the device map to avoid listing every device slot by hand
+	var byName map[string]*InstanceConfigDevice
+
+	data, err := json.Marshal(devices)
+	if err != nil || json.Unmarshal(data, &byName) != nil {
+		return nil
+	}
+
+	result := make([]terraformConfigDevice, 0, len(byName))
+
+	for name, device := range byName {
+		if device != nil {
+			result = append(result, terraformConfigDevice{name: name, InstanceConfigDevice: *device})
+		}
+	}
+
+	// Sort sdb before sdaa
+	slices.SortFunc(result, func(a, b terraformConfigDevice) int {
+		if len(a.name) != len(b.name) {
+			return len(a.name) - len(b.name)
+		}
+
+		return strings.Compare(a.name, b.name)
+	})
+
+	return result
+}
+
+func (e *terraformExporter) collectVolumes(ctx context.Context) error {
+	if !e.wants("linode_volume") {
+		return nil
+	}
+
+	volumes, err := e.client.ListVolumes(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list volumes: %w", err)
+	}
+
+	for _, volume := range volumes {
+		e.add("linode_volume", volume.Label, strconv.Itoa(volume.ID), func(body *hclBody) {
+			body.set("label", volume.Label)
+			body.set("region", volume.Region)
+			body.set("size", volume.Size)
+			body.setOptional("tags", volume.Tags)
+
+			if volume.LinodeID != nil {
+				body.set("linode_id", e.ref("linode_instance", strconv.Itoa(*volume.LinodeID), *volume.LinodeID))
+			}
+		})
+	}
+
+	return nil
+}
+
+func (e *terraformExporter) collectNodeBalancers(ctx context.Context) error {
+	if !e.wants("linode_nodebalancer", "linode_nodebalancer_config", "linode_nodebalancer_node") {
+		return nil
+	}
+
+	nodebalancers, err := e.client.ListNodeBalancers(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list NodeBalancers: %w", err)
+	}
+
+	for _, nodebalancer := range nodebalancers {
+		label := ""
+		if nodebalancer.Label != nil {
+			label = *nodebalancer.Label
+		}
+
+		nodebalancerID := strconv.Itoa(nodebalancer.ID)
+
+		e.add("linode_nodebalancer", label, nodebalancerID, func(body *hclBody) {
+			body.set("label", label)
+			body.set("region", nodebalancer.Region)
+			body.setOptional("client_conn_throttle", nodebalancer.ClientConnThrottle)
+			body.setOptional("tags", nodebalancer.Tags)
+		})
+
+		if !e.wants("linode_nodebalancer_config", "linode_nodebalancer_node") {
+			continue
+		}
+
+		configs, err := e.client.ListNodeBalancerConfigs(ctx, nodebalancer.ID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list configs of NodeBalancer %d: %w", nodebalancer.ID, err)
+		}
+
+		for _, config := range configs {
+			configLabel := fmt.Sprintf("%s_%d", label, config.Port)
+			configID := fmt.Sprintf("%d,%d", nodebalancer.ID, config.ID)
+
+			e.add("linode_nodebalancer_config", configLabel, configID, func(body *hclBody) {
+				body.set("nodebalancer_id", e.ref("linode_nodebalancer", nodebalancerID, nodebalancer.ID))
+				body.set("port", config.Port)
+				body.set("protocol", config.Protocol)
+				body.setOptional("proxy_protocol", config.ProxyProtocol)
+				body.setOptional("algorithm", config.Algorithm)
+				body.setOptional("stickiness", config.Stickiness)
+				body.setOptional("check", config.Check)
+				body.setOptional("check_interval", config.CheckInterval)
+				body.setOptional("check_attempts", config.CheckAttempts)
+				body.setOptional("check_timeout", config.CheckTimeout)
+				body.setOptional("check_path", config.CheckPath)
+				body.setOptional("check_body", config.CheckBody)
+				body.setOptional("check_passive", config.CheckPassive)
+				body.setOptional("cipher_suite", config.CipherSuite)
+			})
+
+			if !e.wants("linode_nodebalancer_node") {
+				continue
+			}
+
+			nodes, err := e.client.ListNodeBalancerNodes(ctx, nodebalancer.ID, config.ID, nil)
+			if err != nil {
+				return fmt.Errorf("failed to list nodes of NodeBalancer %d config %d: %w",
+					nodebalancer.ID, config.ID, err)
+			}
+
+			for _, node := range nodes {
+				e.add("linode_nodebalancer_node", configLabel+"_"+node.Label,
+					fmt.Sprintf("%d,%d,%d", nodebalancer.ID, config.ID, node.ID), func(body *hclBody) {
+						body.set("nodebalancer_id", e.ref("linode_nodebalancer", nodebalancerID, nodebalancer.ID))
+						body.set("config_id", e.ref("linode_nodebalancer_config", configID, config.ID))
+						body.set("label", node.Label)
+						body.set("address", node.Address)
+						body.setOptional("weight", node.Weight)
+						body.setOptional("mode", node.Mode)
+					})
+			}
+		}
+	}
+
+	return nil
+}
+
+func (e *terraformExporter) collectFirewalls(ctx context.Context) error {
+	if !e.wants("linode_firewall") {
+		return nil
+	}
+
+	firewalls, err := e.client.ListFirewalls(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list firewalls: %w", err)
+	}
+
+	for _, firewall := range firewalls {
+		e.add("linode_firewall", firewall.Label, strconv.Itoa(firewall.ID), func(body *hclBody) {
+			body.set("label", firewall.Label)
+			body.setOptional("tags", firewall.Tags)
+			body.set("inbound_policy", firewall.Rules.InboundPolicy)
+			body.set("outbound_policy", firewall.Rules.OutboundPolicy)
+
+			for _, rule := range firewall.Rules.Inbound {
+				renderFirewallRule(body.block("inbound"), rule.Label, rule.Action, rule.Protocol, rule.Ports, rule.Addresses)
+			}
+
+			for _, rule := range firewall.Rules.Outbound {
+				renderFirewallRule(body.block("outbound"), rule.Label, rule.Action, rule.Protocol, rule.Ports, rule.Addresses)
+			}
+
+			linodes := make([]any, 0)
+			nodebalancers := make([]any, 0)
+
+			for _, entity := range firewall.Entities {
+				switch entity.Type {
+				case FirewallDeviceLinode:
+					linodes = append(linodes, e.ref("linode_instance", strconv.Itoa(entity.ID), entity.ID))
+				case FirewallDeviceNodeBalancer:
+					nodebalancers = append(nodebalancers, e.ref("linode_nodebalancer", strconv.Itoa(entity.ID), entity.ID))
+				}
+			}
+
+			body.setOptional("linodes", linodes)
+			body.setOptional("nodebalancers", nodebalancers)
+		})
+	}
+
+	return nil
+}
+
+func renderFirewallRule(body *hclBody, label, action string, protocol NetworkProtocol, ports string, addresses NetworkAddresses) {
+	body.set("label", label)
+	body.set("action", action)
+	body.set("protocol", protocol)
+	body.setOptional("ports", ports)
+	body.setOptional("ipv4", addresses.IPv4)
+	body.setOptional("ipv6", addresses.IPv6)
+}
+
+func (e *terraformExporter) collectDomains(ctx context.Context) error {
+	if !e.wants("linode_domain", "linode_domain_record") {
+		return nil
+	}
+
+	domains, err := e.client.ListDomains(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list domains: %w", err)
+	}
+
+	for _, domain := range domains {
+		domainID := strconv.Itoa(domain.ID)
+
+		e.add("linode_domain", domain.Domain, domainID, func(body *hclBody) {
+			body.set("domain", domain.Domain)
+			body.set("type", domain.Type)
+			body.setOptional("soa_email", domain.SOAEmail)
+			body.setOptional("description", domain.Description)
+			body.setOptional("master_ips", domain.MasterIPs)
+			body.setOptional("axfr_ips", domain.AXfrIPs)
+			body.setOptional("ttl_sec", domain.TTLSec)
+			body.setOptional("retry_sec", domain.RetrySec)
+			body.setOptional("expire_sec", domain.ExpireSec)
+			body.setOptional("refresh_sec", domain.RefreshSec)
+			body.setOptional("tags", domain.Tags)
+		})
+
+		if !e.wants("linode_domain_record") {
+			continue
+		}
+
+		records, err := e.client.ListDomainRecords(ctx, domain.ID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list records of domain %s: %w", domain.Domain, err)
+		}
+
+		for _, record := range records {
+			e.add("linode_domain_record", fmt.Sprintf("%s_%s_%s", domain.Domain, record.Type, record.Name),
+				fmt.Sprintf("%d,%d", domain.ID, record.ID), func(body *hclBody) {
+					body.set("domain_id", e.ref("linode_domain", domainID, domain.ID))
+					body.set("name", record.Name)
+					body.set("record_type", record.Type)
+					body.set("target", record.Target)
+					body.setOptional("ttl_sec", record.TTLSec)
+					body.setOptional("priority", record.Priority)
+					body.setOptional("weight", record.Weight)
+					body.setOptional("port", record.Port)
+					body.setOptional("service", record.Service)
+					body.setOptional("protocol", record.Protocol)
+					body.setOptional("tag", record.Tag)
+				})
+		}
+	}
+
+	return nil
+}
+
+func (e *terraformExporter) collectLKEClusters(ctx context.Context) error {
+	if !e.wants("linode_lke_cluster") {
+		return nil
+	}
+
+	clusters, err := e.client.ListLKEClusters(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list LKE clusters: %w", err)
+	}
+
+	for _, cluster := range clusters {
+		pools, err := e.client.ListLKENodePools(ctx, cluster.ID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list node pools of LKE cluster %d: %w", cluster.ID, err)
+		}
+
+		e.add("linode_lke_cluster", cluster.Label, strconv.Itoa(cluster.ID), func(body *hclBody) {
+			body.set("label", cluster.Label)
+			body.set("region", cluster.Region)
+			body.set("k8s_version", cluster.K8sVersion)
+			body.setOptional("tags", cluster.Tags)
+
+			for _, pool := range pools {
+				block := body.block("pool")
+				block.set("type", pool.Type)
+				block.set("count", pool.Count)
+				block.setOptional("tags", pool.Tags)
+
+				if pool.Autoscaler.Enabled {
+					autoscaler := block.block("autoscaler")
+					autoscaler.set("min", pool.Autoscaler.Min)
+					autoscaler.set("max", pool.Autoscaler.Max)
+				}
+			}
+		})
+	}
+
+	return nil
+}
+
+func (e *terraformExporter) collectBuckets(ctx context.Context) error {
+	if !e.wants("linode_object_storage_bucket") {
+		return nil
+	}
+
+	buckets, err := e.client.ListObjectStorageBuckets(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list Object Storage buckets: %w", err)
+	}
+
+	for _, bucket := range buckets {
+		e.add("linode_object_storage_bucket", bucket.Label, bucket.Region+":"+bucket.Label, func(body *hclBody) {
+			body.set("region", bucket.Region)
+			body.set("label", bucket.Label)
+		})
+	}
+
+	return nil
+}
+
+func (e *terraformExporter) collectDatabases(ctx context.Context) error {
+	if e.wants("linode_database_mysql_v2") {
+		databases, err := e.client.ListMySQLDatabases(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list MySQL databases: %w", err)
+		}
+
+		for _, database := range databases {
+			e.addDatabase("linode_database_mysql_v2", database.ID, database.Label, database.Engine,
+				database.Version, database.Region, database.Type, database.ClusterSize, database.AllowList)
+		}
+	}
+
+	if e.wants("linode_database_postgresql_v2") {
+		databases, err := e.client.ListPostgresDatabases(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list PostgreSQL databases: %w", err)
+		}
+
+		for _, database := range databases {
+			e.addDatabase("linode_database_postgresql_v2", database.ID, database.Label, database.Engine,
+				database.Version, database.Region, database.Type, database.ClusterSize, database.AllowList)
+		}
+	}
+
+	return nil
+}
+
+func (e *terraformExporter) addDatabase(
+	resourceType string,
+	id int,
+	label, engine, version, region, databaseType string,
+	clusterSize int,
+	allowList []string,
+) {
+	e.add(resourceType, label, strconv.Itoa(id), func(body *hclBody) {
+		// Engine IDs only include the major version, e.g. mysql/8
+		body.set("label", label)
+		body.set("engine_id", engine+"/"+strings.SplitN(version, ".", 2)[0])
+		body.set("region", region)
+		body.set("type", databaseType)
+		body.setOptional("cluster_size", clusterSize)
+		body.setOptional("allow_list", allowList)
+	})
+}
+
+// terraformName converts the given label to a valid Terraform resource name.
+func terraformName(label string) string {
+	var builder strings.Builder
+
+	separated := true
+
+	for _, r := range strings.ToLower(label) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			builder.WriteRune(r)
+
+			separated = false
+
+			continue
+		}
+
+		if !separated {
+			builder.WriteRune('_')
+
+			separated = true
+		}
+	}
+
+	name := strings.TrimSuffix(builder.String(), "_")
+
+	switch {
+	case name == "":
+		return "resource"
+	case name[0] >= '0' && name[0] <= '9':
+		return "r_" + name
+	}
+
+	return name
+}
+
+// hclRef is a raw HCL expression, e.g. a reference to another resource's attribute.
+type hclRef string
+
+// hclBody is the body of an HCL block, holding attributes and nested blocks in order.
+type hclBody struct {
+	entries []hclEntry
+}
+
+type hclEntry struct {
+	name  string
+	value any
+	block *hclBody
+}
+
+// set sets the attribute with the given name to the given value.
+func (b *hclBody) set(name string, value any) {
+	b.entries = append(b.entries, hclEntry{name: name, value: value})
+}
+
+// setOptional sets the attribute with the given name unless the given value is a zero value or empty.
+func (b *hclBody) setOptional(name string, value any) {
+	if value == nil {
+		return
+	}
+
+	v := reflect.ValueOf(value)
+	if v.IsZero() || (v.Kind() == reflect.Slice && v.Len() == 0) {
+		return
+	}
+
+	b.set(name, value)
+}
+
+// block adds a nested block with the given name and returns its body.
+func (b *hclBody) block(name string) *hclBody {
+	body := &hclBody{}
+	b.entries = append(b.entries, hclEntry{name: name, block: body})
+
+	return body
+}
+
+// write writes the body in canonical `terraform fmt` style, aligning the equals signs
+// of consecutive attributes and separating nested blocks with blank lines.
+func (b *hclBody) write(builder *strings.Builder, depth int) {
+	indent := strings.Repeat("  ", depth)
+
+	for i, entry := range b.entries {
+		if entry.block != nil {
+			if i > 0 {
+				builder.WriteString("\n")
+			}
+
+			fmt.Fprintf(builder, "%s%s {\n", indent, entry.name)
+			entry.block.write(builder, depth+1)
+			fmt.Fprintf(builder, "%s}\n", indent)
+
+			continue
+		}
+
+		if i > 0 && b.entries[i-1].block != nil {
+			builder.WriteString("\n")
+		}
+
+		fmt.Fprintf(builder, "%s%-*s = %s\n", indent, b.attributeWidth(i), entry.name, hclValue(entry.value))
+	}
+}
+
+// attributeWidth returns the length of the longest attribute name in the run of
+// consecutive attributes containing the entry at the given index.
+func (b *hclBody) attributeWidth(index int) int {
+	start, end := index, index
+
+	for start > 0 && b.entries[start-1].block == nil {
+		start--
+	}
+
+	for end < len(b.entries)-1 && b.entries[end+1].block == nil {
+		end++
+	}
+
+	width := 0
+
+	for _, entry := range b.entries[start : end+1] {
+		width = max(width, len(entry.name))
+	}
+
+	return width
+}
+
+// hclValue formats the given value as an HCL expression.
+func hclValue(value any) string {
+	if ref, ok := value.(hclRef); ok {
+		return string(ref)
+	}
+
+	v := reflect.ValueOf(value)
+
+	switch v.Kind() {
+	case reflect.String:
+		return hclQuote(v.String())
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return "null"
+		}
+
+		return hclValue(v.Elem().Interface())
+	case reflect.Slice:
+		items := make([]string, v.Len())
+		for i := range items {
+			items[i] = hclValue(v.Index(i).Interface())
+		}
+
+		return "[" + strings.Join(items, ", ") + "]"
+	default:
+		return "null"
+	}
+}
+
+// hclQuote quotes the given string as an HCL string literal, escaping template sequences.
+func hclQuote(value string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+		"${", "$${",
+		"%{", "%%{",
+	)
+
+	return `"` + replacer.Replace(value) + `"`
+}
@@ -0,0 +1,148 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunBatch(t *testing.T) {
+	client := createMockClient(t)
+
+	var running, maxRunning atomic.Int32
+
+	httpmock.RegisterRegexpResponder("PUT", mockRequestURL(t, `linode/instances/(\d+)`),
+		func(req *http.Request) (*http.Response, error) {
+			current := running.Add(1)
+			defer running.Add(-1)
+
+			for {
+				previous := maxRunning.Load()
+				if current <= previous || maxRunning.CompareAndSwap(previous, current) {
+					break
+				}
+			}
+
+			time.Sleep(5 * time.Millisecond)
+
+			id, err := httpmock.GetSubmatchAsInt(req, 1)
+			require.NoError(t, err)
+
+			if id == 3 {
+				return httpmock.NewStringResponse(http.StatusBadRequest,
+					`{"errors": [{"reason": "Invalid tag", "field": "tags"}]}`), nil
+			}
+
+			return httpmock.NewJsonResponse(http.StatusOK, linodego.Instance{ID: int(id)})
+		})
+
+	ids := []int{1, 2, 3, 4, 5, 6}
+
+	operations := linodego.BatchOperations(ids, strconv.Itoa,
+		func(ctx context.Context, client *linodego.Client, id int) error {
+			_, err := client.UpdateInstance(ctx, id, linodego.InstanceUpdateOptions{Tags: []string{"batch"}})
+			return err
+		})
+
+	// Rebooting the instance depends on it being tagged successfully
+	operations = append(operations, linodego.BatchOperation{
+		Key:       "reboot-3",
+		DependsOn: []string{"3"},
+		Run: func(_ context.Context, _ *linodego.Client) error {
+			return errors.New("should not run")
+		},
+	})
+
+	var settled atomic.Int32
+
+	report, err := client.RunBatch(context.Background(), operations, &linodego.BatchOptions{
+		Concurrency: 2,
+		OnResult: func(_ linodego.BatchResult) {
+			settled.Add(1)
+		},
+	})
+	require.NoError(t, err)
+
+	assert.LessOrEqual(t, maxRunning.Load(), int32(2))
+	assert.EqualValues(t, 7, settled.Load())
+
+	require.Len(t, report.Results, 7)
+	assert.Equal(t, "1", report.Results[0].Key)
+	assert.Len(t, report.Succeeded(), 5)
+
+	failed := report.Failed()
+	require.Len(t, failed, 1)
+	assert.Equal(t, "3", failed[0].Key)
+	require.NotNil(t, failed[0].APIError)
+	assert.Equal(t, http.StatusBadRequest, failed[0].APIError.StatusCode())
+
+	skipped := report.Skipped()
+	require.Len(t, skipped, 1)
+	assert.Equal(t, "reboot-3", skipped[0].Key)
+	assert.ErrorContains(t, skipped[0].Err, "dependency 3 did not succeed")
+
+	assert.ErrorContains(t, report.Err(), "operation 3 failed")
+}
+
+func TestRunBatch_StopOnError(t *testing.T) {
+	client := createMockClient(t)
+
+	var ran atomic.Int32
+
+	operations := make([]linodego.BatchOperation, 5)
+	for i := range operations {
+		operations[i].Run = func(_ context.Context, _ *linodego.Client) error {
+			ran.Add(1)
+
+			if i == 1 {
+				return errors.New("failed")
+			}
+
+			return nil
+		}
+	}
+
+	report, err := client.RunBatch(context.Background(), operations, &linodego.BatchOptions{
+		Concurrency: 1,
+		Policy:      linodego.BatchStopOnError,
+	})
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 2, ran.Load())
+	assert.Len(t, report.Succeeded(), 1)
+	assert.Len(t, report.Failed(), 1)
+	assert.Len(t, report.Skipped(), 3)
+	assert.ErrorContains(t, report.Skipped()[0].Err, "batch stopped after operation 1 failed")
+}
+
+func TestRunBatch_Invalid(t *testing.T) {
+	client := createMockClient(t)
+
+	noop := func(_ context.Context, _ *linodego.Client) error { return nil }
+
+	_, err := client.RunBatch(context.Background(), []linodego.BatchOperation{
+		{Key: "a", DependsOn: []string{"b"}, Run: noop},
+		{Key: "b", DependsOn: []string{"a"}, Run: noop},
+	}, nil)
+	assert.ErrorContains(t, err, "cyclic dependencies")
+
+	_, err = client.RunBatch(context.Background(), []linodego.BatchOperation{
+		{Key: "a", DependsOn: []string{"missing"}, Run: noop},
+	}, nil)
+	assert.ErrorContains(t, err, "unknown operation missing")
+
+	_, err = client.RunBatch(context.Background(), []linodego.BatchOperation{
+		{Key: "a", Run: noop},
+		{Key: "a", Run: noop},
+	}, nil)
+	assert.ErrorContains(t, err, "duplicate batch operation key a")
+}
@@ -0,0 +1,114 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchLoader_Instances(t *testing.T) {
+	client := createMockClient(t)
+
+	var requestCount atomic.Int32
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances"),
+		func(req *http.Request) (*http.Response, error) {
+			requestCount.Add(1)
+
+			var filter map[string][]map[string]int
+			require.NoError(t, json.Unmarshal([]byte(req.Header.Get("X-Filter")), &filter))
+
+			instances := make([]linodego.Instance, 0)
+
+			for _, node := range filter["+or"] {
+				// Simulate a missing instance
+				if node["id"] == 3 {
+					continue
+				}
+
+				instances = append(instances, linodego.Instance{ID: node["id"]})
+			}
+
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+				"data":    instances,
+				"page":    1,
+				"pages":   1,
+				"results": len(instances),
+			})
+		})
+
+	loader := client.NewInstanceBatchLoader(&linodego.BatchLoaderOptions{Window: 50 * time.Millisecond})
+
+	var wg sync.WaitGroup
+
+	ids := []int{1, 2, 3, 2}
+	results := make([]*linodego.Instance, len(ids))
+	errs := make([]error, len(ids))
+
+	for i, id := range ids {
+		wg.Go(func() {
+			results[i], errs[i] = loader.Get(context.Background(), id)
+		})
+	}
+
+	wg.Wait()
+
+	assert.EqualValues(t, 1, requestCount.Load(), "expected a single list request")
+
+	require.NoError(t, errs[0])
+	assert.Equal(t, 1, results[0].ID)
+
+	require.NoError(t, errs[1])
+	assert.Equal(t, 2, results[1].ID)
+
+	assert.True(t, linodego.IsNotFound(errs[2]), "expected missing ID to be not found")
+
+	require.NoError(t, errs[3])
+	assert.Equal(t, 2, results[3].ID)
+	assert.NotSame(t, results[1], results[3], "expected callers to receive separate copies")
+}
+
+func TestBatchLoader_MaxBatchSize(t *testing.T) {
+	client := createMockClient(t)
+
+	var requestCount atomic.Int32
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "volumes"),
+		func(req *http.Request) (*http.Response, error) {
+			requestCount.Add(1)
+
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+				"data":    []linodego.Volume{},
+				"page":    1,
+				"pages":   1,
+				"results": 0,
+			})
+		})
+
+	loader := client.NewVolumeBatchLoader(&linodego.BatchLoaderOptions{
+		Window:       time.Hour,
+		MaxBatchSize: 2,
+	})
+
+	var wg sync.WaitGroup
+
+	for id := range 4 {
+		wg.Go(func() {
+			_, err := loader.Get(context.Background(), id)
+			assert.True(t, linodego.IsNotFound(err))
+		})
+	}
+
+	wg.Wait()
+
+	assert.EqualValues(t, 2, requestCount.Load(), "expected full batches to be flushed immediately")
+}
@@ -0,0 +1,188 @@
+package unit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockBillingResources(t *testing.T) {
+	mockListEndpoint(t, "linode/instances", []linodego.Instance{
+		{ID: 1, Label: "web", Region: "us-east", Tags: []string{"team:web"}},
+		{ID: 2, Label: "lke50-pool-abc", Region: "us-east", Tags: []string{"team:k8s", "prod"}},
+	})
+	mockListEndpoint(t, "volumes", []linodego.Volume{
+		{ID: 10, Label: "data", Region: "us-east", Tags: []string{"team:web"}},
+	})
+	mockListEndpoint(t, "nodebalancers", []linodego.NodeBalancer{})
+	mockListEndpoint(t, "lke/clusters", []map[string]any{
+		{"id": 50, "label": "k8s", "region": "us-east", "tags": []string{"team:k8s"}},
+	})
+	mockListEndpoint(t, "databases/mysql/instances", []map[string]any{})
+	mockListEndpoint(t, "databases/postgresql/instances", []map[string]any{})
+
+	mockListEndpoint(t, "account/invoices", []map[string]any{
+		{"id": 100, "date": "2026-08-01T00:00:00", "total": 999},
+		{"id": 101, "date": "2026-09-01T00:00:00", "total": 76.5},
+	})
+	mockListEndpoint(t, "account/invoices/101/items", []map[string]any{
+		{"label": "Linode 4GB - web (1)", "type": "hourly", "amount": 24, "total": 24, "region": "us-east"},
+		{"label": "Backup Service - Linode 4GB - web (1)", "type": "hourly", "amount": 5, "total": 5},
+		{"label": "Linode 4GB - lke50-pool-abc (2)", "type": "hourly", "amount": 24, "total": 24},
+		{"label": "LKE Control Plane HA - k8s (50)", "type": "hourly", "amount": 10.1, "total": 10.1},
+		{"label": "Volume - data (10)", "type": "hourly", "amount": 2, "total": 2},
+		{"label": "Linode 2GB - old (3)", "type": "hourly", "amount": 6, "total": 6},
+		{"label": "NodeBalancer - gone (20)", "type": "hourly", "amount": 3, "total": 3},
+		{"label": "Network Transfer", "type": "misc", "amount": 2.4, "total": 2.4},
+	})
+}
+
+func TestExportBilling(t *testing.T) {
+	client := createMockClient(t)
+
+	mockBillingResources(t)
+
+	// Instance 3 has since been deleted, but was captured by an earlier snapshot
+	historical := &linodego.InventorySnapshot{
+		TakenAt: time.Date(2026, 8, 15, 0, 0, 0, 0, time.UTC),
+		Resources: map[string]map[string]json.RawMessage{
+			"instances": {
+				"1": json.RawMessage(`{"id": 1, "label": "web-old", "region": "us-west", "tags": ["team:old"]}`),
+				"3": json.RawMessage(`{"id": 3, "label": "old", "region": "us-west", "tags": ["team:web"]}`),
+			},
+		},
+	}
+
+	export, err := client.ExportBilling(context.Background(), &linodego.BillingExportOptions{
+		From:      time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+		To:        time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		Snapshots: []*linodego.InventorySnapshot{historical},
+	})
+	require.NoError(t, err)
+
+	type item struct {
+		EntityType linodego.EntityType
+		EntityID   int
+		Region     string
+		Reason     string
+	}
+
+	toItems := func(lineItems []linodego.BillingLineItem) []item {
+		result := make([]item, len(lineItems))
+		for i, it := range lineItems {
+			result[i] = item{it.EntityType, it.EntityID, it.Region, it.Reason}
+		}
+
+		return result
+	}
+
+	assert.Equal(t, []item{
+		{linodego.EntityLinode, 1, "us-east", ""},
+		{linodego.EntityLinode, 1, "us-east", ""},
+		{linodego.EntityLinode, 2, "us-east", ""},
+		{linodego.EntityLKECluster, 50, "us-east", ""},
+		{linodego.EntityVolume, 10, "us-east", ""},
+		{linodego.EntityLinode, 3, "us-west", ""},
+	}, toItems(export.Items))
+
+	assert.Equal(t, []item{
+		{"", 0, "", "resource 20 not found"},
+		{"", 0, "", "label has no resource ID"},
+	}, toItems(export.Unattributed))
+
+	assert.Equal(t, linodego.BillingSummary{Amount: 76.5, Total: 76.5}, export.Total)
+	assert.InDelta(t, 37, export.ByTag["team:web"].Total, 0.001)
+	assert.InDelta(t, 34.1, export.ByTag["team:k8s"].Total, 0.001)
+	assert.InDelta(t, 24, export.ByTag["prod"].Total, 0.001)
+	assert.NotContains(t, export.ByTag, "team:old")
+	assert.InDelta(t, 6, export.ByRegion["us-west"].Total, 0.001)
+	assert.InDelta(t, 59, export.ByType["linode"].Total, 0.001)
+
+	var summary bytes.Buffer
+	require.NoError(t, export.WriteSummaryCSV(&summary, linodego.BillingGroupByType))
+	assert.Equal(t, `type,amount,tax,total
+linode,59.00,0.00,59.00
+lkecluster,10.10,0.00,10.10
+volume,2.00,0.00,2.00
+unattributed,5.40,0.00,5.40
+`, summary.String())
+
+	var items bytes.Buffer
+	require.NoError(t, export.WriteItemsCSV(&items))
+	assert.Contains(t, items.String(),
+		"101,2026-09-01T00:00:00Z,Linode 4GB - lke50-pool-abc (2),hourly,us-east,,,24.00,0.00,24.00,linode,2,lke50-pool-abc,team:k8s;prod,\n")
+
+	var encoded bytes.Buffer
+	require.NoError(t, export.WriteJSON(&encoded))
+	assert.Contains(t, encoded.String(), `"reason": "resource 20 not found"`)
+
+	assert.ErrorContains(t, export.WriteSummaryCSV(&summary, "team"), "unsupported billing grouping team")
+}
+
+func TestExportBilling_LabelFallback(t *testing.T) {
+	client := createMockClient(t)
+
+	mockListEndpoint(t, "linode/instances", []linodego.Instance{
+		{ID: 1, Label: "web-1", Region: "us-east", Tags: []string{"team:web"}},
+		{ID: 2, Label: "dup", Region: "us-east"},
+		{ID: 3, Label: "dup", Region: "us-east"},
+	})
+	mockListEndpoint(t, "volumes", []linodego.Volume{
+		{ID: 10, Label: "web-1", Region: "us-east"},
+	})
+	mockListEndpoint(t, "nodebalancers", []linodego.NodeBalancer{})
+	mockListEndpoint(t, "lke/clusters", []map[string]any{})
+	mockListEndpoint(t, "databases/mysql/instances", []map[string]any{})
+	mockListEndpoint(t, "databases/postgresql/instances", []map[string]any{})
+
+	var filter string
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, `account/invoices(\?.*)?$`),
+		func(req *http.Request) (*http.Response, error) {
+			filter = req.Header.Get("X-Filter")
+
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+				"data":    []map[string]any{{"id": 101, "date": "2026-09-01T00:00:00", "total": 20}},
+				"page":    1,
+				"pages":   1,
+				"results": 1,
+			})
+		})
+	mockListEndpoint(t, "account/invoices/101/items", []map[string]any{
+		{"label": "Linode 2GB - web-1", "type": "hourly", "amount": 12, "total": 12},
+		{"label": "Volume - web-1", "type": "hourly", "amount": 2, "total": 2},
+		{"label": "Linode 2GB - dup", "type": "hourly", "amount": 3, "total": 3},
+		{"label": "Linode 2GB - gone", "type": "hourly", "amount": 3, "total": 3},
+	})
+
+	export, err := client.ExportBilling(context.Background(), &linodego.BillingExportOptions{
+		From: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	// The date range should be filtered by the API rather than listing every invoice
+	assert.JSONEq(t, `{"+and": [
+		{"date": {"+gte": "2026-09-01T00:00:00"}},
+		{"date": {"+lt": "2026-10-01T00:00:00"}}
+	]}`, filter)
+
+	require.Len(t, export.Items, 2)
+	assert.Equal(t, linodego.EntityLinode, export.Items[0].EntityType)
+	assert.Equal(t, 1, export.Items[0].EntityID)
+	assert.Equal(t, []string{"team:web"}, export.Items[0].Tags)
+	assert.Equal(t, linodego.EntityVolume, export.Items[1].EntityType)
+	assert.Equal(t, 10, export.Items[1].EntityID)
+
+	require.Len(t, export.Unattributed, 2)
+	assert.Equal(t, "resource dup is ambiguous", export.Unattributed[0].Reason)
+	assert.Equal(t, "resource gone not found", export.Unattributed[1].Reason)
+}
@@ -0,0 +1,181 @@
+package unit
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockCostPriceTypes(t *testing.T) {
+	mockPriceTypes(t)
+
+	mockListEndpoint(t, "lke/types", []map[string]any{
+		{"id": "lke-sa", "price": map[string]any{"hourly": 0, "monthly": 0}},
+		{"id": "lke-ha", "price": map[string]any{"hourly": 0.09, "monthly": 60}},
+	})
+	mockListEndpoint(t, "databases/types", []map[string]any{
+		{
+			"id": "g6-dedicated-2",
+			"engines": map[string]any{
+				"mysql": []map[string]any{
+					{"quantity": 1, "price": map[string]any{"hourly": 0.1, "monthly": 65}},
+					{"quantity": 3, "price": map[string]any{"hourly": 0.3, "monthly": 195}},
+				},
+				"postgresql": []map[string]any{
+					{"quantity": 1, "price": map[string]any{"hourly": 0.1, "monthly": 65}},
+				},
+			},
+		},
+	})
+	mockListEndpoint(t, "network-transfer/prices", []map[string]any{
+		{"id": "network_transfer", "price": map[string]any{"hourly": 0.005, "monthly": 0.005}},
+	})
+}
+
+func TestCostEstimator_EstimateAccount(t *testing.T) {
+	client := createMockClient(t)
+
+	mockCostPriceTypes(t)
+
+	mockListEndpoint(t, "linode/instances", []linodego.Instance{
+		{ID: 1, Label: "web", Type: "g6-standard-2", Region: "id-cgk", Tags: []string{"prod"}},
+		{
+			ID: 2, Label: "db", Type: "g6-standard-2", Region: "us-east", Tags: []string{"prod", "data"},
+			Backups: &linodego.InstanceBackup{Enabled: true},
+		},
+	})
+	mockListEndpoint(t, "volumes", []linodego.Volume{
+		{ID: 10, Label: "data", Region: "us-east", Size: 100},
+	})
+	mockListEndpoint(t, "nodebalancers", []linodego.NodeBalancer{{ID: 20, Region: "us-east"}})
+	mockListEndpoint(t, "lke/clusters", []map[string]any{
+		{"id": 50, "label": "k8s", "region": "us-east", "control_plane": map[string]any{"high_availability": true}},
+	})
+	mockListEndpoint(t, "databases/mysql/instances", []map[string]any{
+		{"id": 60, "label": "mysql", "region": "us-east", "type": "g6-dedicated-2", "cluster_size": 3},
+	})
+	mockListEndpoint(t, "databases/postgresql/instances", []map[string]any{})
+	mockListEndpoint(t, "networking/reserved/ips", []linodego.InstanceIP{})
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account/transfer$"),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, linodego.AccountTransfer{Billable: 200}))
+
+	report, err := client.NewCostEstimator().EstimateAccount(context.Background())
+	require.NoError(t, err)
+
+	type item struct {
+		EntityType linodego.EntityType
+		ID         string
+		Monthly    float64
+	}
+
+	items := make([]item, len(report.Items))
+	for i, it := range report.Items {
+		items[i] = item{it.EntityType, it.ID, math.Round(it.Cost.Monthly*100) / 100}
+	}
+
+	assert.Equal(t, []item{
+		{linodego.EntityLinode, "1", 28.8},
+		{linodego.EntityLinode, "2", 29},
+		{linodego.EntityVolume, "10", 10},
+		{linodego.EntityNodebalancer, "20", 10},
+		{linodego.EntityLKECluster, "50", 60},
+		{linodego.EntityDatabase, "60", 195},
+		{linodego.EntityAccount, "network_transfer", 1},
+	}, items)
+
+	assert.InDelta(t, 333.8, report.Total.Monthly, 0.001)
+	assert.InDelta(t, 28.8, report.ByRegion["id-cgk"].Monthly, 0.001)
+	assert.InDelta(t, 57.8, report.ByTag["prod"].Monthly, 0.001)
+	assert.InDelta(t, 29, report.ByTag["data"].Monthly, 0.001)
+	assert.InDelta(t, 276, report.ByTag[""].Monthly, 0.001)
+	assert.InDelta(t, 57.8, report.ByType[linodego.EntityLinode].Monthly, 0.001)
+}
+
+func TestCostEstimator_EstimateAccountUnpriced(t *testing.T) {
+	client := createMockClient(t)
+
+	mockCostPriceTypes(t)
+
+	mockListEndpoint(t, "linode/instances", []linodego.Instance{
+		{ID: 1, Label: "web", Type: "g6-standard-2", Region: "us-east"},
+		{ID: 2, Label: "legacy", Type: "g1-retired", Region: "us-east"},
+	})
+	mockListEndpoint(t, "volumes", []linodego.Volume{})
+	mockListEndpoint(t, "nodebalancers", []linodego.NodeBalancer{})
+	mockListEndpoint(t, "lke/clusters", []linodego.LKECluster{})
+	mockListEndpoint(t, "databases/mysql/instances", []map[string]any{})
+	mockListEndpoint(t, "databases/postgresql/instances", []map[string]any{
+		{"id": 60, "label": "pg", "region": "us-east", "type": "g6-dedicated-2", "cluster_size": 3},
+	})
+	mockListEndpoint(t, "networking/reserved/ips", []linodego.InstanceIP{})
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account/transfer$"),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, linodego.AccountTransfer{}))
+
+	report, err := client.NewCostEstimator().EstimateAccount(context.Background())
+	require.NoError(t, err)
+	require.Len(t, report.Items, 3)
+
+	assert.Empty(t, report.Items[0].Unpriced)
+	assert.Equal(t, "unknown instance type g1-retired", report.Items[1].Unpriced)
+	assert.Equal(t, "database type g6-dedicated-2 has no price for postgresql clusters of 3 nodes", report.Items[2].Unpriced)
+	assert.Zero(t, report.Items[1].Cost)
+	assert.Zero(t, report.Items[2].Cost)
+	assert.InDelta(t, 24, report.Total.Monthly, 0.001)
+}
+
+func TestCostEstimator_EstimateCreate(t *testing.T) {
+	client := createMockClient(t)
+
+	mockCostPriceTypes(t)
+
+	estimator := client.NewCostEstimator()
+
+	cost, err := estimator.EstimateInstanceCreate(context.Background(), linodego.InstanceCreateOptions{
+		Type: "g6-standard-2", Region: "id-cgk", BackupsEnabled: true,
+	})
+	require.NoError(t, err)
+	assert.InDelta(t, 33.8, cost.Monthly, 0.001)
+
+	highAvailability := true
+
+	cost, err = estimator.EstimateLKEClusterCreate(context.Background(), linodego.LKEClusterCreateOptions{
+		Region:       "us-east",
+		NodePools:    []linodego.LKENodePoolCreateOptions{{Type: "g6-standard-2", Count: 3}},
+		ControlPlane: &linodego.LKEClusterControlPlaneOptions{HighAvailability: &highAvailability},
+	})
+	require.NoError(t, err)
+	assert.InDelta(t, 132, cost.Monthly, 0.001)
+
+	_, err = estimator.EstimateInstanceCreate(context.Background(), linodego.InstanceCreateOptions{Type: "g6-unknown"})
+	assert.ErrorContains(t, err, "unknown instance type g6-unknown")
+}
+
+func TestCostEstimator_EstimateChanges(t *testing.T) {
+	client := createMockClient(t)
+
+	mockCostPriceTypes(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "lke/clusters/50$"),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, linodego.LKECluster{ID: 50, Region: "id-cgk"}))
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "lke/clusters/50/pools/500$"),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, linodego.LKENodePool{ID: 500, Type: "g6-standard-2", Count: 2}))
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "databases/mysql/instances/60$"),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, map[string]any{"id": 60, "type": "g6-dedicated-2", "cluster_size": 1}))
+
+	estimator := client.NewCostEstimator()
+
+	change, err := estimator.EstimateLKENodePoolResize(context.Background(), 50, 500, 5)
+	require.NoError(t, err)
+	assert.InDelta(t, 57.6, change.Before.Monthly, 0.001)
+	assert.InDelta(t, 86.4, change.Delta().Monthly, 0.001)
+
+	change, err = estimator.EstimateMySQLUpdate(context.Background(), 60, linodego.MySQLUpdateOptions{ClusterSize: 3})
+	require.NoError(t, err)
+	assert.InDelta(t, 130, change.Delta().Monthly, 0.001)
+}
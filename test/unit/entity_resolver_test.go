@@ -0,0 +1,81 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveEntity(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123$"),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, linodego.Instance{ID: 123, Label: "test"}))
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123/disks/456"),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, linodego.InstanceDisk{ID: 456, Label: "boot"}))
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "images/private-789"),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, linodego.Image{ID: "private/789", Label: "image"}))
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "object-storage/buckets/us-east/my-bucket"),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, linodego.ObjectStorageBucket{Label: "my-bucket"}))
+
+	ctx := context.Background()
+
+	// Event entity IDs are decoded as floats
+	instance, err := linodego.ResolveEntityAs[linodego.Instance](ctx, client,
+		linodego.EventEntity{ID: float64(123), Type: linodego.EntityLinode})
+	require.NoError(t, err)
+	assert.Equal(t, "test", instance.Label)
+
+	// Nested entities are resolved using their URL
+	disk, err := linodego.ResolveEntityAs[linodego.InstanceDisk](ctx, client, linodego.EventEntity{
+		ID:   456,
+		Type: linodego.EntityDisk,
+		URL:  "/v4/linode/instances/123/disks/456",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "boot", disk.Label)
+
+	image, err := linodego.ResolveEntityAs[linodego.Image](ctx, client,
+		linodego.EventEntity{ID: "private-789", Type: linodego.EntityImage})
+	require.NoError(t, err)
+	assert.Equal(t, "image", image.Label)
+
+	bucket, err := client.ResolveEntity(ctx, linodego.TicketEntity{
+		Label: "my-bucket",
+		Type:  "bucket",
+		URL:   "/v4/object-storage/buckets/us-east/my-bucket",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "my-bucket", bucket.(*linodego.ObjectStorageBucket).Label)
+
+	resolved, err := client.ResolveEntity(ctx, linodego.LinodeEntity{ID: 123, Type: "linode"})
+	require.NoError(t, err)
+	assert.IsType(t, &linodego.Instance{}, resolved)
+
+	// Resolving to an unexpected type should fail
+	_, err = linodego.ResolveEntityAs[linodego.Volume](ctx, client,
+		linodego.LinodeEntity{ID: 123, Type: "linode"})
+	assert.ErrorContains(t, err, "rather than *linodego.Volume")
+}
+
+func TestResolveEntity_Unsupported(t *testing.T) {
+	client := createMockClient(t)
+
+	_, err := client.ResolveEntity(context.Background(), linodego.EventEntity{
+		ID:   1,
+		Type: "community",
+		URL:  "https://www.linode.com/community/questions/1",
+	})
+
+	var unsupportedErr *linodego.UnsupportedEntityError
+	require.ErrorAs(t, err, &unsupportedErr)
+	assert.Equal(t, linodego.EntityType("community"), unsupportedErr.Type)
+}
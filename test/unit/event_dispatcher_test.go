@@ -0,0 +1,251 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventDispatcher(t *testing.T) {
+	client := createMockClient(t)
+
+	var instanceRequests atomic.Int32
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123"),
+		func(_ *http.Request) (*http.Response, error) {
+			instanceRequests.Add(1)
+			return httpmock.NewJsonResponse(http.StatusOK, linodego.Instance{ID: 123, Label: "test"})
+		})
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "volumes/456"),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, linodego.Volume{ID: 456, Label: "vol"}))
+
+	var (
+		lock     sync.Mutex
+		handled  []string
+		failures []error
+		attempts atomic.Int32
+	)
+
+	dispatcher := client.NewEventDispatcher(&linodego.EventDispatcherOptions{
+		MaxRetries: 2,
+		RetryDelay: time.Millisecond,
+		OnError: func(_ linodego.ResolvedEvent, err error) {
+			lock.Lock()
+			defer lock.Unlock()
+
+			failures = append(failures, err)
+		},
+	})
+
+	linodego.HandleEntity(dispatcher, linodego.EventMatch{
+		Actions:  []linodego.EventAction{linodego.ActionLinodeCreate},
+		Statuses: []linodego.EventStatus{linodego.EventFinished},
+	}, func(_ context.Context, event linodego.ResolvedEvent, instance *linodego.Instance) error {
+		lock.Lock()
+		defer lock.Unlock()
+
+		handled = append(handled, "created:"+instance.Label)
+
+		return nil
+	})
+
+	linodego.HandleEntity(dispatcher, linodego.EventMatch{
+		EntityTypes: []linodego.EntityType{linodego.EntityLinode},
+	}, func(_ context.Context, event linodego.ResolvedEvent, instance *linodego.Instance) error {
+		lock.Lock()
+		defer lock.Unlock()
+
+		handled = append(handled, "any:"+string(event.Event.Status))
+
+		return nil
+	})
+
+	// A failing handler should be retried and must not affect other handlers
+	dispatcher.Handle(linodego.EventMatch{
+		Actions: []linodego.EventAction{linodego.ActionLinodeCreate},
+	}, func(_ context.Context, _ linodego.ResolvedEvent) error {
+		attempts.Add(1)
+		return errors.New("handler failed")
+	})
+
+	linodego.HandleEntity(dispatcher, linodego.EventMatch{
+		Actions: []linodego.EventAction{linodego.ActionVolumeAttach},
+	}, func(_ context.Context, event linodego.ResolvedEvent, volume *linodego.Volume) error {
+		lock.Lock()
+		defer lock.Unlock()
+
+		handled = append(handled, "attached:"+volume.Label+":"+event.SecondaryEntity.(*linodego.Instance).Label)
+
+		return nil
+	})
+
+	linode := &linodego.EventEntity{ID: float64(123), Type: linodego.EntityLinode}
+
+	require.NoError(t, dispatcher.Dispatch(context.Background(), linodego.EventUpdate{
+		Event: linodego.Event{ID: 1, Action: linodego.ActionLinodeCreate, Status: linodego.EventStarted, Entity: linode},
+	}))
+
+	require.NoError(t, dispatcher.Dispatch(context.Background(), linodego.EventUpdate{
+		Event: linodego.Event{
+			ID:              2,
+			Action:          linodego.ActionVolumeAttach,
+			Status:          linodego.EventStarted,
+			Entity:          &linodego.EventEntity{ID: 456, Type: linodego.EntityVolume},
+			SecondaryEntity: linode,
+		},
+	}))
+
+	// The instance should be served from the cache until an event for it completes
+	assert.EqualValues(t, 1, instanceRequests.Load())
+
+	require.NoError(t, dispatcher.Dispatch(context.Background(), linodego.EventUpdate{
+		Event:          linodego.Event{ID: 1, Action: linodego.ActionLinodeCreate, Status: linodego.EventFinished, Entity: linode},
+		PreviousStatus: linodego.EventStarted,
+	}))
+
+	assert.EqualValues(t, 2, instanceRequests.Load())
+
+	assert.ElementsMatch(t, []string{
+		"any:started",
+		"attached:vol:test",
+		"created:test",
+		"any:finished",
+	}, handled)
+
+	// Each of the two linode_create events is attempted three times
+	assert.EqualValues(t, 6, attempts.Load())
+	require.Len(t, failures, 2)
+	assert.ErrorContains(t, failures[0], "handler failed")
+}
+
+func TestEventDispatcher_UnresolvableEntity(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123"),
+		httpmock.NewStringResponder(http.StatusNotFound, `{"errors": [{"reason": "Not found"}]}`))
+
+	dispatcher := client.NewEventDispatcher(nil)
+
+	var resolveErr error
+
+	dispatcher.Handle(linodego.EventMatch{}, func(_ context.Context, event linodego.ResolvedEvent) error {
+		resolveErr = event.ResolveErr
+		return nil
+	})
+
+	require.NoError(t, dispatcher.Dispatch(context.Background(), linodego.EventUpdate{
+		Event: linodego.Event{
+			ID:     1,
+			Action: linodego.ActionLinodeDelete,
+			Status: linodego.EventFinished,
+			Entity: &linodego.EventEntity{ID: 123, Type: linodego.EntityLinode},
+		},
+	}))
+
+	assert.True(t, linodego.IsNotFound(resolveErr))
+}
+
+func TestEventDispatcher_HandleEntityReportsResolveErr(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123"),
+		httpmock.NewStringResponder(http.StatusForbidden, `{"errors": [{"reason": "Unauthorized"}]}`))
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/456"),
+		httpmock.NewStringResponder(http.StatusNotFound, `{"errors": [{"reason": "Not found"}]}`))
+
+	var failures []error
+
+	dispatcher := client.NewEventDispatcher(&linodego.EventDispatcherOptions{
+		OnError: func(_ linodego.ResolvedEvent, err error) {
+			failures = append(failures, err)
+		},
+	})
+
+	called := false
+
+	linodego.HandleEntity(dispatcher, linodego.EventMatch{}, func(context.Context, linodego.ResolvedEvent, *linodego.Instance) error {
+		called = true
+		return nil
+	})
+
+	require.NoError(t, dispatcher.Dispatch(context.Background(), linodego.EventUpdate{
+		Event: linodego.Event{
+			ID:     1,
+			Action: linodego.ActionLinodeBoot,
+			Status: linodego.EventFinished,
+			Entity: &linodego.EventEntity{ID: 123, Type: linodego.EntityLinode},
+		},
+	}))
+
+	assert.False(t, called)
+	require.Len(t, failures, 1)
+	assert.True(t, linodego.ErrHasStatus(failures[0], http.StatusForbidden))
+
+	// Events for deleted entities should be skipped rather than reported
+	require.NoError(t, dispatcher.Dispatch(context.Background(), linodego.EventUpdate{
+		Event: linodego.Event{
+			ID:     2,
+			Action: linodego.ActionLinodeDelete,
+			Status: linodego.EventFinished,
+			Entity: &linodego.EventEntity{ID: 456, Type: linodego.EntityLinode},
+		},
+	}))
+
+	assert.False(t, called)
+	assert.Len(t, failures, 1)
+}
+
+func TestEventDispatcher_HandlersReceiveCopies(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123"),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, linodego.Instance{ID: 123, Label: "test", Tags: []string{"foo"}}))
+
+	var (
+		lock     sync.Mutex
+		observed [][]string
+	)
+
+	dispatcher := client.NewEventDispatcher(&linodego.EventDispatcherOptions{Concurrency: 1})
+
+	handler := func(_ context.Context, _ linodego.ResolvedEvent, instance *linodego.Instance) error {
+		lock.Lock()
+		defer lock.Unlock()
+
+		observed = append(observed, slices.Clone(instance.Tags))
+
+		// Modifications must not be visible to other handlers or later events
+		instance.Tags[0] = "modified"
+		instance.Label = "modified"
+
+		return nil
+	}
+
+	linodego.HandleEntity(dispatcher, linodego.EventMatch{}, handler)
+	linodego.HandleEntity(dispatcher, linodego.EventMatch{}, handler)
+
+	for id := range 2 {
+		require.NoError(t, dispatcher.Dispatch(context.Background(), linodego.EventUpdate{
+			Event: linodego.Event{
+				ID:     id,
+				Action: linodego.ActionLinodeBoot,
+				Status: linodego.EventStarted,
+				Entity: &linodego.EventEntity{ID: 123, Type: linodego.EntityLinode},
+			},
+		}))
+	}
+
+	assert.Equal(t, [][]string{{"foo"}, {"foo"}, {"foo"}, {"foo"}}, observed)
+}
@@ -0,0 +1,197 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockEventStream serves the account's events, supporting the
+// id filters used by the event subscriber.
+type mockEventStream struct {
+	lock   sync.Mutex
+	events []linodego.Event
+	seen   []string
+
+	// orSizes records the number of IDs in each "+or" filter
+	orSizes []int
+}
+
+func (s *mockEventStream) set(event linodego.Event) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for i := range s.events {
+		if s.events[i].ID == event.ID {
+			s.events[i] = event
+			return
+		}
+	}
+
+	s.events = append(s.events, event)
+}
+
+func (s *mockEventStream) register(t *testing.T) {
+	httpmock.RegisterRegexpResponder("GET", regexp.MustCompile(`/[a-zA-Z0-9]+/account/events(\?.*)?$`),
+		func(req *http.Request) (*http.Response, error) {
+			var filter struct {
+				ID *struct {
+					Gt int `json:"+gt"`
+				} `json:"id"`
+				Or []map[string]int `json:"+or"`
+			}
+
+			if header := req.Header.Get("X-Filter"); header != "" {
+				require.NoError(t, json.Unmarshal([]byte(header), &filter))
+			}
+
+			s.lock.Lock()
+			defer s.lock.Unlock()
+
+			if filter.Or != nil {
+				s.orSizes = append(s.orSizes, len(filter.Or))
+			}
+
+			result := make([]linodego.Event, 0)
+
+			for _, event := range s.events {
+				if filter.ID != nil && event.ID <= filter.ID.Gt {
+					continue
+				}
+
+				if filter.Or != nil && !containsEventID(filter.Or, event.ID) {
+					continue
+				}
+
+				result = append(result, event)
+			}
+
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+				"data":    result,
+				"page":    1,
+				"pages":   1,
+				"results": len(result),
+			})
+		})
+
+	httpmock.RegisterRegexpResponder("POST", regexp.MustCompile(`/[a-zA-Z0-9]+/account/events/\d+/seen$`),
+		func(req *http.Request) (*http.Response, error) {
+			s.lock.Lock()
+			s.seen = append(s.seen, req.URL.Path)
+			s.lock.Unlock()
+
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{})
+		})
+}
+
+func containsEventID(nodes []map[string]int, id int) bool {
+	for _, node := range nodes {
+		if node["id"] == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestEventSubscriber(t *testing.T) {
+	client := createMockClient(t)
+
+	stream := &mockEventStream{}
+	stream.register(t)
+
+	linode := &linodego.EventEntity{ID: 123, Type: linodego.EntityLinode}
+	volume := &linodego.EventEntity{ID: 456, Type: linodego.EntityVolume}
+
+	// Existing events should not be delivered
+	stream.set(linodego.Event{ID: 1, Action: linodego.ActionLinodeCreate, Status: linodego.EventFinished, Entity: linode})
+
+	store := linodego.FileEventCheckpointStore{Path: filepath.Join(t.TempDir(), "checkpoint.json")}
+
+	subscriber := client.NewEventSubscriber(&linodego.EventSubscriberOptions{
+		EntityTypes: []linodego.EntityType{linodego.EntityLinode},
+		MarkSeen:    true,
+		Store:       store,
+	})
+
+	var updates []string
+
+	handler := func(_ context.Context, update linodego.EventUpdate) error {
+		updates = append(updates, string(update.PreviousStatus)+">"+string(update.Event.Status))
+		return nil
+	}
+
+	require.NoError(t, subscriber.Poll(context.Background(), handler))
+	assert.Empty(t, updates)
+
+	stream.set(linodego.Event{ID: 2, Action: linodego.ActionLinodeBoot, Status: linodego.EventStarted, Entity: linode})
+	stream.set(linodego.Event{ID: 3, Action: linodego.ActionVolumeCreate, Status: linodego.EventFinished, Entity: volume})
+
+	require.NoError(t, subscriber.Poll(context.Background(), handler))
+	assert.Equal(t, []string{">started"}, updates)
+
+	// Polling again without changes should not deliver duplicates
+	require.NoError(t, subscriber.Poll(context.Background(), handler))
+	assert.Equal(t, []string{">started"}, updates)
+
+	stream.set(linodego.Event{ID: 2, Action: linodego.ActionLinodeBoot, Status: linodego.EventFinished, Entity: linode})
+
+	require.NoError(t, subscriber.Poll(context.Background(), handler))
+	assert.Equal(t, []string{">started", "started>finished"}, updates)
+
+	checkpoint, err := store.LoadCheckpoint(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, checkpoint.LastEventID)
+	assert.Empty(t, checkpoint.Pending)
+
+	// A new subscriber using the same store should resume from the checkpoint
+	stream.set(linodego.Event{ID: 4, Action: linodego.ActionLinodeShutdown, Status: linodego.EventScheduled, Entity: linode})
+
+	updates = nil
+
+	resumed := client.NewEventSubscriber(&linodego.EventSubscriberOptions{Store: store})
+	require.NoError(t, resumed.Poll(context.Background(), handler))
+	assert.Equal(t, []string{">scheduled"}, updates)
+
+	assert.Len(t, stream.seen, 1)
+	assert.Contains(t, stream.seen[0], "/account/events/3/seen")
+}
+
+func TestEventSubscriber_ManyPendingEvents(t *testing.T) {
+	client := createMockClient(t)
+
+	stream := &mockEventStream{}
+	stream.register(t)
+
+	linode := &linodego.EventEntity{ID: 123, Type: linodego.EntityLinode}
+	pending := make(map[int]linodego.EventStatus)
+
+	for id := 1; id <= 250; id++ {
+		stream.set(linodego.Event{ID: id, Action: linodego.ActionLinodeBoot, Status: linodego.EventFinished, Entity: linode})
+		pending[id] = linodego.EventStarted
+	}
+
+	store := linodego.FileEventCheckpointStore{Path: filepath.Join(t.TempDir(), "checkpoint.json")}
+	require.NoError(t, store.SaveCheckpoint(context.Background(), &linodego.EventCheckpoint{LastEventID: 250, Pending: pending}))
+
+	subscriber := client.NewEventSubscriber(&linodego.EventSubscriberOptions{Store: store})
+
+	updates := 0
+
+	require.NoError(t, subscriber.Poll(context.Background(), func(_ context.Context, update linodego.EventUpdate) error {
+		updates++
+		return nil
+	}))
+
+	assert.Equal(t, 250, updates)
+	assert.Equal(t, []int{100, 100, 50}, stream.orSizes)
+}
@@ -0,0 +1,148 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockPriceTypes(t *testing.T) {
+	mockListEndpoint(t, "linode/types", []map[string]any{
+		{
+			"id":            "g6-standard-2",
+			"price":         map[string]any{"hourly": 0.036, "monthly": 24},
+			"region_prices": []map[string]any{{"id": "id-cgk", "hourly": 0.043, "monthly": 28.8}},
+			"addons": map[string]any{
+				"backups": map[string]any{"price": map[string]any{"hourly": 0.008, "monthly": 5}},
+			},
+		},
+	})
+	mockListEndpoint(t, "volumes/types", []map[string]any{
+		{
+			"id":            "volume",
+			"price":         map[string]any{"hourly": 0.00015, "monthly": 0.1},
+			"region_prices": []map[string]any{{"id": "id-cgk", "hourly": 0.00018, "monthly": 0.12}},
+		},
+	})
+	mockListEndpoint(t, "nodebalancers/types", []map[string]any{
+		{"id": "premium", "price": map[string]any{"hourly": 0.45, "monthly": 300}},
+		{"id": "nodebalancer", "price": map[string]any{"hourly": 0.015, "monthly": 10}},
+	})
+	mockListEndpoint(t, "networking/reserved/ips/types", []map[string]any{
+		{"id": "ipv4", "price": map[string]any{"hourly": 0.005, "monthly": 2}},
+	})
+}
+
+func TestFindIdleResources(t *testing.T) {
+	client := createMockClient(t)
+
+	mockPriceTypes(t)
+
+	linodeID := 1
+	nbLabel := "idle-lb"
+	now := time.Now().UTC().Format("2006-01-02T15:04:05")
+
+	mockListEndpoint(t, "volumes", []linodego.Volume{
+		{ID: 10, Label: "attached", Region: "us-east", Size: 20, LinodeID: &linodeID},
+		{ID: 11, Label: "orphan", Region: "id-cgk", Size: 50},
+	})
+	mockListEndpoint(t, "nodebalancers", []linodego.NodeBalancer{
+		{ID: 20, Label: &nbLabel, Region: "us-east"},
+		{ID: 21, Region: "us-east"},
+	})
+	mockListEndpoint(t, "nodebalancers/20/configs", []linodego.NodeBalancerConfig{
+		{ID: 200, NodesStatus: &linodego.NodeBalancerNodeStatus{Down: 2}},
+	})
+	mockListEndpoint(t, "nodebalancers/21/configs", []linodego.NodeBalancerConfig{
+		{ID: 210, NodesStatus: &linodego.NodeBalancerNodeStatus{Up: 1}},
+	})
+	mockListEndpoint(t, "networking/reserved/ips", []linodego.InstanceIP{
+		{Address: "192.0.2.1", Region: "us-east", Reserved: true},
+		{Address: "192.0.2.2", Region: "us-east", Reserved: true, LinodeID: 1},
+	})
+	mockListEndpoint(t, "networking/firewalls", []linodego.Firewall{
+		{ID: 30, Label: "unused-fw"},
+		{ID: 31, Label: "used-fw", Entities: []linodego.FirewallDeviceEntity{{ID: 1, Type: linodego.FirewallDeviceLinode}}},
+	})
+	mockListEndpoint(t, "linode/instances", []any{
+		linodego.Instance{ID: 1, Label: "running", Status: linodego.InstanceRunning, Image: "private/1"},
+		linodego.Instance{
+			ID: 2, Label: "stopped", Status: linodego.InstanceOffline, Type: "g6-standard-2", Region: "us-east",
+			Backups: &linodego.InstanceBackup{Enabled: true},
+		},
+		// Without shutdown events, recent updates shouldn't hide that the instance is powered off
+		map[string]any{
+			"id": 3, "label": "stopped-long-ago", "status": "offline", "type": "g6-standard-2", "region": "us-east",
+			"created": "2020-01-01T00:00:00", "updated": now,
+		},
+		// Instances of retired types can't be priced, but should still be reported
+		map[string]any{
+			"id": 5, "label": "retired", "status": "offline", "type": "g1-retired", "region": "us-east",
+			"created": "2020-01-01T00:00:00",
+		},
+		map[string]any{
+			"id": 4, "label": "new", "status": "offline", "type": "g6-standard-2", "region": "us-east",
+			"created": now, "updated": now,
+		},
+	})
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, `account/events(\?.*)?$`),
+		func(req *http.Request) (*http.Response, error) {
+			events := []map[string]any{}
+
+			if strings.Contains(req.Header.Get("X-Filter"), `"entity.id":2`) {
+				events = append(events, map[string]any{
+					"id": 1, "action": "linode_shutdown", "created": "2020-01-02T03:04:05",
+					"entity": map[string]any{"id": 2, "type": "linode"},
+				})
+			}
+
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+				"data": events, "page": 1, "pages": 1, "results": len(events),
+			})
+		})
+	mockListEndpoint(t, "images", []map[string]any{
+		{"id": "private/1", "label": "in-use", "created": "2020-01-01T00:00:00"},
+		{"id": "private/2", "label": "stale", "created": "2020-01-01T00:00:00"},
+	})
+	mockListEndpoint(t, "object-storage/keys", []linodego.ObjectStorageKey{
+		{ID: 40, Label: "unlimited"},
+		{ID: 41, Label: "no-access", Limited: true, BucketAccess: &[]linodego.ObjectStorageKeyBucketAccess{}},
+	})
+
+	report, err := client.FindIdleResources(context.Background(), nil)
+	require.NoError(t, err)
+
+	type finding struct {
+		Kind linodego.IdleResourceKind
+		ID   string
+		Cost float64
+	}
+
+	findings := make([]finding, len(report.Findings))
+	for i, f := range report.Findings {
+		findings[i] = finding{f.Kind, f.ID, f.EstimatedMonthlyCost}
+	}
+
+	assert.Equal(t, []finding{
+		{linodego.IdleUnattachedVolume, "11", 6},
+		{linodego.IdleNodeBalancerWithoutNodes, "20", 10},
+		{linodego.IdleUnassignedReservedIP, "192.0.2.1", 2},
+		{linodego.IdleFirewallWithoutDevices, "30", 0},
+		{linodego.IdlePoweredOffInstance, "2", 29},
+		{linodego.IdlePoweredOffInstance, "3", 24},
+		{linodego.IdlePoweredOffInstance, "5", 0},
+		{linodego.IdleUnusedImage, "private/2", 0},
+		{linodego.IdleObjectStorageKeyNoAccess, "41", 0},
+	}, findings)
+
+	assert.Equal(t, "instance has been powered off since 2020-01-02", report.Findings[4].Reason)
+	assert.Equal(t, "instance has been powered off since before its oldest retained event", report.Findings[5].Reason)
+	assert.InDelta(t, 71, report.EstimatedMonthlyCost(), 0.001)
+}
@@ -0,0 +1,292 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockInstances serves the account's instances from a mutable list.
+type mockInstances struct {
+	lock      sync.Mutex
+	instances map[int]linodego.Instance
+}
+
+func (m *mockInstances) set(instances ...linodego.Instance) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.instances = make(map[int]linodego.Instance)
+	for _, instance := range instances {
+		m.instances[instance.ID] = instance
+	}
+}
+
+func (m *mockInstances) register(t *testing.T) {
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, `linode/instances(\?.*)?$`),
+		func(_ *http.Request) (*http.Response, error) {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			result := make([]linodego.Instance, 0, len(m.instances))
+			for _, instance := range m.instances {
+				result = append(result, instance)
+			}
+
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+				"data":    result,
+				"page":    1,
+				"pages":   1,
+				"results": len(result),
+			})
+		})
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, `linode/instances/(\d+)`),
+		func(req *http.Request) (*http.Response, error) {
+			id, err := httpmock.GetSubmatchAsInt(req, 1)
+			require.NoError(t, err)
+
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			instance, ok := m.instances[int(id)]
+			if !ok {
+				return httpmock.NewStringResponse(http.StatusNotFound, `{"errors": [{"reason": "Not found"}]}`), nil
+			}
+
+			return httpmock.NewJsonResponse(http.StatusOK, instance)
+		})
+}
+
+func TestInformer(t *testing.T) {
+	client := createMockClient(t)
+
+	instances := &mockInstances{}
+	instances.register(t)
+
+	instances.set(
+		linodego.Instance{ID: 1, Label: "web-1", Region: "us-east", Tags: []string{"web"}},
+		linodego.Instance{ID: 2, Label: "db-1", Region: "us-west", Tags: []string{"db"}},
+	)
+
+	informer := client.NewInstanceInformer(nil)
+
+	var deltas []string
+
+	informer.AddHandler(context.Background(), func(_ context.Context, delta linodego.InformerDelta[linodego.Instance]) {
+		deltas = append(deltas, string(delta.Type)+":"+delta.Object.Label)
+	})
+
+	require.NoError(t, informer.Resync(context.Background()))
+	assert.True(t, informer.HasSynced())
+	assert.Equal(t, []string{"added:web-1", "added:db-1"}, deltas)
+
+	instances.set(
+		linodego.Instance{ID: 1, Label: "web-1", Region: "us-east", Tags: []string{"web"}},
+		linodego.Instance{ID: 2, Label: "db-1", Region: "us-east", Tags: []string{"db"}},
+		linodego.Instance{ID: 3, Label: "web-2", Region: "us-east", Tags: []string{"web"}},
+	)
+
+	deltas = nil
+
+	require.NoError(t, informer.Resync(context.Background()))
+	assert.Equal(t, []string{"updated:db-1", "added:web-2"}, deltas)
+
+	assert.Len(t, informer.List(), 3)
+	assert.Len(t, informer.ByRegion("us-east"), 3)
+	assert.Empty(t, informer.ByRegion("us-west"))
+	assert.Len(t, informer.ByTag("web"), 2)
+	assert.Equal(t, 2, informer.ByLabel("db-1")[0].ID)
+
+	instance, ok := informer.Get(3)
+	require.True(t, ok)
+	assert.Equal(t, "web-2", instance.Label)
+
+	instances.set(linodego.Instance{ID: 3, Label: "web-2", Region: "us-east", Tags: []string{"web"}})
+
+	deltas = nil
+
+	require.NoError(t, informer.Resync(context.Background()))
+	assert.Equal(t, []string{"deleted:web-1", "deleted:db-1"}, deltas)
+	assert.Len(t, informer.ByTag("web"), 1)
+
+	// Handlers added after syncing should be notified of existing objects
+	var replayed []string
+
+	informer.AddHandler(context.Background(), func(_ context.Context, delta linodego.InformerDelta[linodego.Instance]) {
+		replayed = append(replayed, string(delta.Type)+":"+delta.Object.Label)
+	})
+
+	assert.Equal(t, []string{"added:web-2"}, replayed)
+}
+
+func TestInformer_EventRefresh(t *testing.T) {
+	client := createMockClient(t)
+
+	stream := &mockEventStream{}
+	stream.register(t)
+
+	instances := &mockInstances{}
+	instances.register(t)
+
+	instances.set(linodego.Instance{ID: 1, Label: "web-1"})
+
+	informer := client.NewInstanceInformer(&linodego.InformerOptions{
+		EventRefresh:   true,
+		EventInterval:  10 * time.Millisecond,
+		ResyncInterval: time.Hour,
+	})
+
+	var (
+		lock   sync.Mutex
+		deltas []string
+	)
+
+	informer.AddHandler(context.Background(), func(_ context.Context, delta linodego.InformerDelta[linodego.Instance]) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		deltas = append(deltas, string(delta.Type)+":"+delta.Object.Label)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+
+	go func() {
+		done <- informer.Run(ctx)
+	}()
+
+	require.Eventually(t, informer.HasSynced, time.Second, 5*time.Millisecond)
+
+	// Only the instances referenced by new events should be refreshed
+	instances.set(linodego.Instance{ID: 1, Label: "web-1-renamed"}, linodego.Instance{ID: 2, Label: "unseen"})
+
+	stream.set(linodego.Event{
+		ID:     1,
+		Action: linodego.ActionLinodeUpdate,
+		Status: linodego.EventNotification,
+		Entity: &linodego.EventEntity{ID: float64(1), Type: linodego.EntityLinode},
+	})
+
+	require.Eventually(t, func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+
+		return len(deltas) == 2
+	}, time.Second, 5*time.Millisecond)
+
+	assert.Equal(t, []string{"added:web-1", "updated:web-1-renamed"}, deltas)
+
+	_, ok := informer.Get(2)
+	assert.False(t, ok)
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
+
+func TestInformer_HandlersCannotModifyStore(t *testing.T) {
+	client := createMockClient(t)
+
+	instances := &mockInstances{}
+	instances.register(t)
+
+	instances.set(linodego.Instance{ID: 1, Label: "web-1", Region: "us-east", Tags: []string{"web"}})
+
+	informer := client.NewInstanceInformer(nil)
+
+	var observedTags []string
+
+	for range 2 {
+		informer.AddHandler(context.Background(), func(_ context.Context, delta linodego.InformerDelta[linodego.Instance]) {
+			observedTags = append(observedTags, delta.Object.Tags...)
+
+			delta.Object.Label = "modified"
+			delta.Object.Tags[0] = "modified"
+
+			if delta.Previous != nil {
+				delta.Previous.Region = "modified"
+			}
+		})
+	}
+
+	require.NoError(t, informer.Resync(context.Background()))
+
+	// Each handler should receive its own copy of the delta
+	assert.Equal(t, []string{"web", "web"}, observedTags)
+
+	instance, ok := informer.Get(1)
+	require.True(t, ok)
+	assert.Equal(t, "web-1", instance.Label)
+	assert.Equal(t, []string{"web"}, instance.Tags)
+
+	// Objects returned by getters must not share state with the store
+	instance.Tags[0] = "modified"
+	informer.List()[0].Tags[0] = "modified"
+
+	assert.Len(t, informer.ByTag("web"), 1)
+	assert.Equal(t, []string{"web"}, informer.ByTag("web")[0].Tags)
+
+	instances.set(linodego.Instance{ID: 1, Label: "web-1", Region: "us-west", Tags: []string{"web"}})
+
+	require.NoError(t, informer.Resync(context.Background()))
+
+	instance, ok = informer.Get(1)
+	require.True(t, ok)
+	assert.Equal(t, "web-1", instance.Label)
+	assert.Equal(t, "us-west", instance.Region)
+	assert.Len(t, informer.ByRegion("us-west"), 1)
+}
+
+func TestInformer_RefreshDuringResync(t *testing.T) {
+	client := createMockClient(t)
+
+	listed := make(chan struct{})
+	release := make(chan struct{})
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, `linode/instances(\?.*)?$`),
+		func(_ *http.Request) (*http.Response, error) {
+			// The relist observes the instance before it is updated
+			close(listed)
+			<-release
+
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+				"data":    []linodego.Instance{{ID: 1, Label: "web-1", Status: linodego.InstanceProvisioning}},
+				"page":    1,
+				"pages":   1,
+				"results": 1,
+			})
+		})
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, `linode/instances/1`),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, linodego.Instance{ID: 1, Label: "web-1", Status: linodego.InstanceRunning}))
+
+	informer := client.NewInstanceInformer(nil)
+
+	done := make(chan error, 1)
+
+	go func() {
+		done <- informer.Resync(context.Background())
+	}()
+
+	<-listed
+
+	require.NoError(t, informer.Refresh(context.Background(), 1))
+
+	close(release)
+	require.NoError(t, <-done)
+
+	// The refreshed state is newer than the relist, so it must not be overwritten
+	instance, ok := informer.Get(1)
+	require.True(t, ok)
+	assert.Equal(t, linodego.InstanceRunning, instance.Status)
+	assert.True(t, informer.HasSynced())
+}
@@ -0,0 +1,144 @@
+package unit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTakeInventorySnapshot(t *testing.T) {
+	client := createMockClient(t)
+
+	mockListEndpoint(t, "linode/instances", []linodego.Instance{
+		{ID: 1, Label: "web-1", Region: "us-east"},
+	})
+	mockListEndpoint(t, "volumes", []linodego.Volume{
+		{ID: 20, Label: "data", Size: 20},
+	})
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "account/users"),
+		httpmock.NewStringResponder(http.StatusForbidden, `{"errors": [{"reason": "Unauthorized"}]}`))
+
+	snapshot, err := client.TakeInventorySnapshot(context.Background(), &linodego.InventorySnapshotOptions{
+		ResourceTypes: []string{"instances", "volumes", "users"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, linodego.InventorySnapshotVersion, snapshot.Version)
+	assert.Contains(t, snapshot.Resources["instances"], "1")
+	assert.Contains(t, snapshot.Resources["volumes"], "20")
+	assert.NotContains(t, snapshot.Resources, "users")
+	assert.Contains(t, snapshot.Errors["users"], "Unauthorized")
+
+	var buf bytes.Buffer
+
+	_, err = snapshot.WriteTo(&buf)
+	require.NoError(t, err)
+
+	parsed, err := linodego.ParseInventorySnapshot(buf.Bytes())
+	require.NoError(t, err)
+
+	diff, err := linodego.DiffInventorySnapshots(snapshot, parsed)
+	require.NoError(t, err)
+	assert.True(t, diff.Empty())
+
+	_, err = client.TakeInventorySnapshot(context.Background(), &linodego.InventorySnapshotOptions{
+		ResourceTypes: []string{"regions"},
+	})
+	assert.ErrorContains(t, err, "unsupported inventory resource type regions")
+}
+
+func TestTakeInventorySnapshot_NestedResources(t *testing.T) {
+	client := createMockClient(t)
+
+	mockListEndpoint(t, "domains", []linodego.Domain{{ID: 1, Domain: "example.com"}})
+	mockListEndpoint(t, "domains/1/records", []linodego.DomainRecord{{ID: 10, Name: "www"}})
+	mockListEndpoint(t, "nodebalancers", []linodego.NodeBalancer{{ID: 2}})
+	mockListEndpoint(t, "nodebalancers/2/configs", []linodego.NodeBalancerConfig{{ID: 20, Port: 80}})
+	mockListEndpoint(t, "nodebalancers/2/configs/20/nodes", []linodego.NodeBalancerNode{{ID: 200, Label: "web-1"}})
+	mockListEndpoint(t, "lke/clusters", []map[string]any{{"id": 3, "label": "k8s"}})
+	mockListEndpoint(t, "lke/clusters/3/pools", []linodego.LKENodePool{{ID: 30, Count: 3}})
+	mockListEndpoint(t, "databases/mysql/instances", []linodego.MySQLDatabase{{ID: 4, Engine: "mysql"}})
+
+	snapshot, err := client.TakeInventorySnapshot(context.Background(), &linodego.InventorySnapshotOptions{
+		ResourceTypes: []string{"domain_records", "nodebalancer_configs", "nodebalancer_nodes", "lke_node_pools", "mysql_databases"},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, snapshot.Errors)
+
+	assert.Contains(t, snapshot.Resources["domain_records"], "1/10")
+	assert.Contains(t, snapshot.Resources["nodebalancer_configs"], "2/20")
+	assert.Contains(t, snapshot.Resources["nodebalancer_nodes"], "2/20/200")
+	assert.Contains(t, snapshot.Resources["lke_node_pools"], "3/30")
+	assert.Contains(t, snapshot.Resources["mysql_databases"], "4")
+	assert.NotContains(t, linodego.InventoryResourceTypes(), "databases")
+
+	assert.Subset(t, linodego.InventoryResourceTypes(), []string{"instance_configs", "instance_disks"})
+}
+
+func TestDiffInventorySnapshots(t *testing.T) {
+	snapshot := func(resources map[string]map[string]string) *linodego.InventorySnapshot {
+		result := &linodego.InventorySnapshot{
+			Version:   linodego.InventorySnapshotVersion,
+			Resources: make(map[string]map[string]json.RawMessage),
+		}
+
+		for resourceType, items := range resources {
+			result.Resources[resourceType] = make(map[string]json.RawMessage)
+
+			for key, item := range items {
+				result.Resources[resourceType][key] = json.RawMessage(item)
+			}
+		}
+
+		return result
+	}
+
+	from := snapshot(map[string]map[string]string{
+		"instances": {
+			"1":  `{"id": 1, "label": "web-1", "specs": {"disk": 25600, "memory": 1024}, "tags": ["a"]}`,
+			"2":  `{"id": 2, "label": "old"}`,
+			"10": `{"id": 10, "label": "same"}`,
+		},
+		"volumes": {"20": `{"id": 20}`},
+	})
+
+	to := snapshot(map[string]map[string]string{
+		"instances": {
+			"1":  `{"id": 1, "label": "web-1", "specs": {"disk": 51200, "memory": 1024}, "tags": ["a", "b"]}`,
+			"3":  `{"id": 3, "label": "new"}`,
+			"10": `{"id": 10, "label": "same"}`,
+		},
+		"domains": {"40": `{"id": 40}`},
+	})
+
+	diff, err := linodego.DiffInventorySnapshots(from, to)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"domains", "volumes"}, diff.Incomplete)
+
+	require.Len(t, diff.Changes, 3)
+
+	assert.Equal(t, linodego.InventoryResourceModified, diff.Changes[0].Type)
+	assert.Equal(t, "web-1", diff.Changes[0].Label)
+	assert.Equal(t, []linodego.InventoryFieldChange{
+		{Field: "specs.disk", Old: json.Number("25600"), New: json.Number("51200")},
+		{Field: "tags", Old: []any{"a"}, New: []any{"a", "b"}},
+	}, diff.Changes[0].Fields)
+
+	assert.Equal(t, linodego.InventoryResourceRemoved, diff.Changes[1].Type)
+	assert.Equal(t, "2", diff.Changes[1].Key)
+	assert.Equal(t, "old", diff.Changes[1].Label)
+
+	assert.Equal(t, linodego.InventoryResourceAdded, diff.Changes[2].Type)
+	assert.Equal(t, "3", diff.Changes[2].Key)
+
+	_, err = linodego.ParseInventorySnapshot([]byte(`{"version": 99}`))
+	assert.ErrorContains(t, err, "unsupported inventory snapshot version 99")
+}
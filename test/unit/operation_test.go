@@ -0,0 +1,154 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperation_BootInstance(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	var booted atomic.Bool
+
+	httpmock.RegisterRegexpResponder("GET", regexp.MustCompile(`/[a-zA-Z0-9]+/account/events(\?.*)?$`),
+		func(_ *http.Request) (*http.Response, error) {
+			// A previous boot event which must not be picked up by the operation
+			events := []linodego.Event{{
+				ID:     111,
+				Status: linodego.EventFinished,
+				Action: linodego.ActionLinodeBoot,
+				Entity: &linodego.EventEntity{ID: 123, Type: linodego.EntityLinode},
+			}}
+
+			if booted.Load() {
+				events = append([]linodego.Event{{
+					ID:              222,
+					Status:          linodego.EventStarted,
+					Action:          linodego.ActionLinodeBoot,
+					PercentComplete: 50,
+					Entity:          &linodego.EventEntity{ID: 123, Type: linodego.EntityLinode},
+				}}, events...)
+			}
+
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+				"data":    events,
+				"page":    1,
+				"pages":   1,
+				"results": len(events),
+			})
+		})
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/boot"),
+		func(_ *http.Request) (*http.Response, error) {
+			booted.Store(true)
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{})
+		})
+
+	httpmock.RegisterRegexpResponder("GET", regexp.MustCompile(`/[a-zA-Z0-9]+/account/events/222$`),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, linodego.Event{ID: 222, Status: linodego.EventFinished}))
+
+	operation, err := client.BootInstanceOperation(context.Background(), 123, linodego.InstanceBootOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, linodego.EventScheduled, operation.Status())
+	assert.Nil(t, operation.Event())
+
+	require.NoError(t, operation.Refresh(context.Background()))
+	assert.Equal(t, linodego.EventStarted, operation.Status())
+	assert.Equal(t, 50, operation.Progress())
+	assert.Equal(t, 222, operation.Event().ID)
+
+	require.NoError(t, operation.Wait(waitTestContext(t, time.Second)))
+	assert.Equal(t, linodego.EventFinished, operation.Status())
+	assert.Equal(t, 100, operation.Progress())
+}
+
+func TestOperation_Failed(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	var resized atomic.Bool
+
+	httpmock.RegisterRegexpResponder("GET", regexp.MustCompile(`/[a-zA-Z0-9]+/account/events(\?.*)?$`),
+		func(_ *http.Request) (*http.Response, error) {
+			events := []linodego.Event{}
+
+			if resized.Load() {
+				events = append(events, linodego.Event{
+					ID:     333,
+					Status: linodego.EventFailed,
+					Action: linodego.ActionLinodeResize,
+					Entity: &linodego.EventEntity{ID: 123, Type: linodego.EntityLinode},
+				})
+			}
+
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+				"data":    events,
+				"page":    1,
+				"pages":   1,
+				"results": len(events),
+			})
+		})
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/123/resize"),
+		func(_ *http.Request) (*http.Response, error) {
+			resized.Store(true)
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{})
+		})
+
+	operation, err := client.ResizeInstanceOperation(context.Background(), 123, linodego.InstanceResizeOptions{Type: "g6-standard-2"})
+	require.NoError(t, err)
+
+	err = operation.Wait(waitTestContext(t, time.Second))
+	require.ErrorContains(t, err, "event 333 has failed")
+	assert.Equal(t, linodego.EventFailed, operation.Status())
+}
+
+func TestOperation_ReplicateImage(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "images/private-123/regions"),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, linodego.Image{ID: "private-123"}))
+
+	step := 0
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "images/private-123"),
+		func(_ *http.Request) (*http.Response, error) {
+			step++
+
+			regions := []linodego.ImageRegion{
+				{Region: "us-east", Status: linodego.ImageRegionStatusAvailable},
+				{Region: "us-west", Status: linodego.ImageRegionStatusReplicating},
+			}
+
+			if step > 1 {
+				regions[1].Status = linodego.ImageRegionStatusAvailable
+			}
+
+			return httpmock.NewJsonResponse(http.StatusOK, linodego.Image{ID: "private-123", Regions: regions})
+		})
+
+	_, operation, err := client.ReplicateImageOperation(
+		context.Background(),
+		"private-123",
+		linodego.ImageReplicateOptions{Regions: []string{"us-east", "us-west"}},
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, operation.Refresh(context.Background()))
+	assert.Equal(t, linodego.EventStarted, operation.Status())
+	assert.Equal(t, 50, operation.Progress())
+
+	require.NoError(t, operation.Wait(waitTestContext(t, time.Second)))
+	assert.Equal(t, linodego.EventFinished, operation.Status())
+	assert.Equal(t, 100, operation.Progress())
+}
@@ -0,0 +1,87 @@
+package unit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildResourceGraph(t *testing.T) {
+	client := createMockClient(t)
+
+	linodeID := 1
+	nbLabel := "lb"
+
+	mockListEndpoint(t, "linode/instances", []linodego.Instance{
+		{ID: 1, Label: "web-1", IPv4: []net.IP{net.ParseIP("192.168.1.10")}},
+		{ID: 2, Label: "worker"},
+		{ID: 3, Label: "standalone"},
+	})
+	mockListEndpoint(t, "volumes", []linodego.Volume{
+		{ID: 20, Label: "data", LinodeID: &linodeID},
+	})
+	mockListEndpoint(t, "nodebalancers", []linodego.NodeBalancer{
+		{ID: 5, Label: &nbLabel},
+	})
+	mockListEndpoint(t, "nodebalancers/5/configs", []linodego.NodeBalancerConfig{{ID: 6, Port: 80}})
+	mockListEndpoint(t, "nodebalancers/5/configs/6/nodes", []linodego.NodeBalancerNode{
+		{ID: 7, Address: "192.168.1.10:80"},
+		{ID: 8, Address: "192.168.1.99:80"},
+	})
+	mockListEndpoint(t, "networking/firewalls", []linodego.Firewall{
+		{ID: 30, Label: "web-fw", Entities: []linodego.FirewallDeviceEntity{
+			{ID: 1, Type: linodego.FirewallDeviceLinode},
+			{ID: 5, Type: linodego.FirewallDeviceNodeBalancer},
+		}},
+	})
+	mockListEndpoint(t, "vpcs", []linodego.VPC{
+		{ID: 40, Label: "vpc", Subnets: []linodego.VPCSubnet{
+			{ID: 41, Label: "subnet", Linodes: []linodego.VPCSubnetLinode{{ID: 1}}},
+		}},
+	})
+	mockListEndpoint(t, "lke/clusters", []linodego.LKECluster{{ID: 50, Label: "k8s"}})
+	mockListEndpoint(t, "lke/clusters/50/pools", []linodego.LKENodePool{
+		{ID: 51, Type: "g6-standard-2", Linodes: []linodego.LKENodePoolLinode{{InstanceID: 2}}},
+	})
+	mockListEndpoint(t, "placement/groups", []linodego.PlacementGroup{})
+
+	graph, err := client.BuildResourceGraph(context.Background())
+	require.NoError(t, err)
+
+	keys := func(nodes []linodego.ResourceGraphNode) []string {
+		result := make([]string, len(nodes))
+		for i, node := range nodes {
+			result[i] = node.Key()
+		}
+
+		return result
+	}
+
+	assert.Equal(t, []string{"linode:1", "nodebalancer:5"}, keys(graph.Dependents(linodego.EntityFirewall, 30)))
+	assert.Equal(t, []string{"linode:1", "nodebalancer:5", "subnet:41"}, keys(graph.Dependents(linodego.EntityVPC, 40)))
+	assert.Equal(t, []string{"linode:2", "lkenodepool:51"}, keys(graph.Dependents(linodego.EntityLKECluster, 50)))
+	assert.Equal(t,
+		[]string{"firewall:30", "subnet:41", "volume:20", "vpc:40"},
+		keys(graph.Dependencies(linodego.EntityLinode, 1)))
+
+	components := graph.ConnectedComponents()
+	require.Len(t, components, 3)
+	assert.Equal(t, "firewall:30", components[0][0].Key())
+	assert.Equal(t, []string{"linode:2", "lkecluster:50", "lkenodepool:51"}, keys(components[1]))
+	assert.Equal(t, []string{"linode:3"}, keys(components[2]))
+
+	data, err := json.Marshal(graph)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `{"from":"linode:1","to":"volume:20","relation":"uses_volume"}`)
+
+	var buf bytes.Buffer
+	require.NoError(t, graph.WriteDOT(&buf))
+	assert.Contains(t, buf.String(), `"nodebalancer:5" -> "linode:1" [label="balances_to"];`)
+	assert.Contains(t, buf.String(), `"volume:20" [label="data\nvolume 20"];`)
+}
@@ -0,0 +1,217 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSaga(client *linodego.Client) *linodego.Saga {
+	saga := client.NewSaga()
+
+	linodego.AddSagaStep(saga, "vpc",
+		func(ctx context.Context, client *linodego.Client, _ *linodego.SagaState) (*linodego.VPC, error) {
+			return client.CreateVPC(ctx, linodego.VPCCreateOptions{Label: "stack", Region: "us-east"})
+		},
+		func(ctx context.Context, client *linodego.Client, vpc *linodego.VPC) error {
+			return client.DeleteVPC(ctx, vpc.ID)
+		})
+
+	linodego.AddSagaStep(saga, "subnet",
+		func(ctx context.Context, client *linodego.Client, state *linodego.SagaState) (*linodego.VPCSubnet, error) {
+			vpc := linodego.SagaValue[linodego.VPC](state, "vpc")
+			return client.CreateVPCSubnet(ctx, linodego.VPCSubnetCreateOptions{Label: "stack", IPv4: "10.0.0.0/24"}, vpc.ID)
+		},
+		func(ctx context.Context, client *linodego.Client, subnet *linodego.VPCSubnet) error {
+			return client.DeleteVPCSubnet(ctx, 10, subnet.ID)
+		})
+
+	linodego.AddSagaStep(saga, "instance",
+		func(ctx context.Context, client *linodego.Client, _ *linodego.SagaState) (*linodego.Instance, error) {
+			return client.CreateInstance(ctx, linodego.InstanceCreateOptions{Region: "us-east", Type: "g6-nanode-1"})
+		},
+		func(ctx context.Context, client *linodego.Client, instance *linodego.Instance) error {
+			return client.DeleteInstance(ctx, instance.ID)
+		})
+
+	linodego.AddSagaStep(saga, "attach",
+		func(ctx context.Context, client *linodego.Client, state *linodego.SagaState) (*linodego.Volume, error) {
+			instance := linodego.SagaValue[linodego.Instance](state, "instance")
+			return client.AttachVolume(ctx, 40, &linodego.VolumeAttachOptions{LinodeID: instance.ID})
+		},
+		nil)
+
+	return saga
+}
+
+func TestSaga(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "vpcs$"),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, linodego.VPC{ID: 10}))
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "vpcs/10/subnets$"),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, linodego.VPCSubnet{ID: 20}))
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances$"),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, linodego.Instance{ID: 30}))
+
+	var attachedTo int
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "volumes/40/attach"),
+		func(req *http.Request) (*http.Response, error) {
+			var opts linodego.VolumeAttachOptions
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&opts))
+
+			attachedTo = opts.LinodeID
+
+			return httpmock.NewJsonResponse(http.StatusOK, linodego.Volume{ID: 40, LinodeID: &opts.LinodeID})
+		})
+
+	report, err := newTestSaga(client).Run(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 30, attachedTo)
+
+	for _, step := range report.Steps {
+		assert.Equal(t, linodego.SagaStepCompleted, step.Status)
+	}
+
+	assert.Equal(t, 20, linodego.SagaValue[linodego.VPCSubnet](report.State, "subnet").ID)
+}
+
+func TestSaga_Rollback(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "vpcs$"),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, linodego.VPC{ID: 10}))
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "vpcs/10/subnets$"),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, linodego.VPCSubnet{ID: 20}))
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances$"),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, linodego.Instance{ID: 30}))
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "volumes/40/attach"),
+		httpmock.NewStringResponder(http.StatusBadRequest, `{"errors": [{"reason": "Volume is already attached"}]}`))
+
+	var deleted []string
+
+	deleteResponder := func(status int) httpmock.Responder {
+		return func(req *http.Request) (*http.Response, error) {
+			deleted = append(deleted, req.URL.Path)
+			return httpmock.NewStringResponse(status, `{"errors": [{"reason": "Failed"}]}`), nil
+		}
+	}
+
+	httpmock.RegisterRegexpResponder("DELETE", mockRequestURL(t, "linode/instances/30"), deleteResponder(http.StatusOK))
+	httpmock.RegisterRegexpResponder("DELETE", mockRequestURL(t, "vpcs/10/subnets/20"), deleteResponder(http.StatusConflict))
+	httpmock.RegisterRegexpResponder("DELETE", mockRequestURL(t, "vpcs/10$"), deleteResponder(http.StatusNotFound))
+
+	report, err := newTestSaga(client).Run(context.Background())
+
+	var sagaErr *linodego.SagaError
+	require.ErrorAs(t, err, &sagaErr)
+	assert.Equal(t, "attach", sagaErr.Step)
+	assert.ErrorContains(t, err, "Volume is already attached")
+	assert.ErrorContains(t, err, "failed to roll back step subnet")
+
+	// Completed steps should be rolled back in reverse order
+	require.Len(t, deleted, 3)
+	assert.Contains(t, deleted[0], "linode/instances/30")
+	assert.Contains(t, deleted[1], "vpcs/10/subnets/20")
+	assert.Contains(t, deleted[2], "vpcs/10")
+
+	assert.Equal(t, linodego.SagaStepRolledBack, report.Steps[0].Status)
+	assert.Equal(t, linodego.SagaStepRollbackFailed, report.Steps[1].Status)
+	assert.Equal(t, linodego.SagaStepRolledBack, report.Steps[2].Status)
+	assert.Equal(t, linodego.SagaStepFailed, report.Steps[3].Status)
+
+	leftovers := report.Leftovers()
+	require.Len(t, leftovers, 1)
+	assert.Equal(t, "subnet", leftovers[0].Name)
+	assert.Equal(t, 20, leftovers[0].Value.(*linodego.VPCSubnet).ID)
+}
+
+func TestSaga_RollbackPartialStep(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances$"),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, linodego.Instance{ID: 30}))
+	httpmock.RegisterRegexpResponder("POST", mockRequestURL(t, "linode/instances/30/boot"),
+		httpmock.NewStringResponder(http.StatusBadRequest, `{"errors": [{"reason": "Failed to boot"}]}`))
+
+	var deleted []string
+
+	httpmock.RegisterRegexpResponder("DELETE", mockRequestURL(t, "linode/instances/30"),
+		func(req *http.Request) (*http.Response, error) {
+			deleted = append(deleted, req.URL.Path)
+			return httpmock.NewStringResponse(http.StatusOK, "{}"), nil
+		})
+
+	compensatedNil := false
+
+	saga := client.NewSaga()
+
+	linodego.AddSagaStep(saga, "instance",
+		func(ctx context.Context, client *linodego.Client, _ *linodego.SagaState) (*linodego.Instance, error) {
+			instance, err := client.CreateInstance(ctx, linodego.InstanceCreateOptions{Region: "us-east", Type: "g6-nanode-1"})
+			if err != nil {
+				return nil, err
+			}
+
+			// Return the created instance alongside the error so that it is cleaned up
+			return instance, client.BootInstance(ctx, instance.ID, linodego.InstanceBootOptions{})
+		},
+		func(ctx context.Context, client *linodego.Client, instance *linodego.Instance) error {
+			compensatedNil = compensatedNil || instance == nil
+			return client.DeleteInstance(ctx, instance.ID)
+		})
+
+	report, err := saga.Run(context.Background())
+	assert.ErrorContains(t, err, "Failed to boot")
+	assert.False(t, compensatedNil)
+
+	require.Len(t, deleted, 1)
+	assert.Contains(t, deleted[0], "linode/instances/30")
+	assert.Equal(t, linodego.SagaStepFailed, report.Steps[0].Status)
+	assert.Empty(t, report.Leftovers())
+
+	// Steps failing without a value should not be compensated
+	saga = client.NewSaga()
+
+	linodego.AddSagaStep(saga, "instance",
+		func(_ context.Context, _ *linodego.Client, _ *linodego.SagaState) (*linodego.Instance, error) {
+			return nil, assert.AnError
+		},
+		func(_ context.Context, _ *linodego.Client, instance *linodego.Instance) error {
+			compensatedNil = compensatedNil || instance == nil
+			return nil
+		})
+
+	report, err = saga.Run(context.Background())
+	require.ErrorIs(t, err, assert.AnError)
+	assert.False(t, compensatedNil)
+	assert.Nil(t, report.Steps[0].Value)
+
+	// Failed steps which can't be rolled back should report both errors
+	compensateErr := errors.New("failed to delete")
+
+	saga = client.NewSaga()
+
+	linodego.AddSagaStep(saga, "instance",
+		func(_ context.Context, _ *linodego.Client, _ *linodego.SagaState) (*linodego.Instance, error) {
+			return &linodego.Instance{ID: 30}, assert.AnError
+		},
+		func(_ context.Context, _ *linodego.Client, _ *linodego.Instance) error {
+			return compensateErr
+		})
+
+	report, err = saga.Run(context.Background())
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Equal(t, linodego.SagaStepRollbackFailed, report.Steps[0].Status)
+	assert.ErrorIs(t, report.Steps[0].Err, assert.AnError)
+	assert.ErrorIs(t, report.Steps[0].Err, compensateErr)
+}
@@ -0,0 +1,230 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testStackManifest = `
+tags: [stack]
+instances:
+  - label: web-1
+    region: us-east
+    type: g6-nanode-1
+firewalls:
+  - label: web-fw
+    rules:
+      inbound_policy: DROP
+      outbound_policy: ACCEPT
+volumes:
+  - label: data
+    region: us-east
+    size: 20
+domains:
+  - domain: example.com
+    type: master
+    soa_email: admin@example.com
+    records:
+      - type: A
+        name: www
+        target: 192.0.2.1
+        ttl_sec: 300
+      - type: A
+        name: api
+        target: 192.0.2.2
+`
+
+func TestStackManifest_PlanApply(t *testing.T) {
+	client := createMockClient(t)
+
+	manifest, err := linodego.ParseStackManifest([]byte(testStackManifest))
+	require.NoError(t, err)
+
+	mockListEndpoint(t, "linode/instances", []linodego.Instance{
+		{ID: 1, Label: "web-1", Tags: []string{}},
+		{ID: 2, Label: "old", Tags: []string{"stack"}},
+		{ID: 3, Label: "unrelated", Tags: []string{"other"}},
+	})
+	mockListEndpoint(t, "volumes", []linodego.Volume{})
+	mockListEndpoint(t, "networking/firewalls", []linodego.Firewall{})
+	mockListEndpoint(t, "nodebalancers", []linodego.NodeBalancer{})
+	mockListEndpoint(t, "domains", []linodego.Domain{
+		{ID: 10, Domain: "example.com", Type: linodego.DomainTypeMaster, SOAEmail: "admin@example.com", Tags: []string{"stack"}},
+	})
+	mockListEndpoint(t, "domains/10/records", []linodego.DomainRecord{
+		{ID: 100, Type: linodego.RecordTypeA, Name: "www", Target: "192.0.2.1", TTLSec: 3600},
+		{ID: 101, Type: linodego.RecordTypeA, Name: "old", Target: "192.0.2.3"},
+	})
+
+	plan, err := client.PlanStack(context.Background(), manifest)
+	require.NoError(t, err)
+
+	changes := make([]string, len(plan.Changes))
+	for i, change := range plan.Changes {
+		changes[i] = change.String()
+	}
+
+	assert.Equal(t, []string{
+		"create firewall web-fw",
+		"create volume data",
+		"create domain_record example.com A api 192.0.2.2",
+		"update instance web-1 (tags)",
+		"update domain_record example.com A www 192.0.2.1 (ttl_sec)",
+		"delete domain_record example.com A old 192.0.2.3",
+		"delete instance old",
+	}, changes)
+
+	var (
+		lock     sync.Mutex
+		requests []string
+	)
+
+	record := func(req *http.Request) (*http.Response, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		// Strip the API version from the path
+		requests = append(requests, req.Method+" "+strings.SplitN(req.URL.Path, "/", 3)[2])
+
+		return httpmock.NewJsonResponse(http.StatusOK, map[string]any{})
+	}
+
+	for _, method := range []string{"POST", "PUT", "DELETE"} {
+		httpmock.RegisterRegexpResponder(method, mockRequestURL(t, ".*"), record)
+	}
+
+	report, err := client.ApplyStackPlan(context.Background(), plan)
+	require.NoError(t, err)
+	assert.Len(t, report.Succeeded(), 7)
+
+	assert.Equal(t, []string{
+		"POST networking/firewalls",
+		"POST volumes",
+		"POST domains/10/records",
+		"PUT linode/instances/1",
+		"PUT domains/10/records/100",
+		"DELETE domains/10/records/101",
+		"DELETE linode/instances/2",
+	}, requests)
+}
+
+func TestParseStackManifest_UnknownField(t *testing.T) {
+	_, err := linodego.ParseStackManifest([]byte(`
+volumes:
+  - label: data
+    regoin: us-east
+`))
+	assert.ErrorContains(t, err, "regoin")
+}
+
+func TestStackManifest_MissingReference(t *testing.T) {
+	client := createMockClient(t)
+
+	manifest, err := linodego.ParseStackManifest([]byte(`
+volumes:
+  - label: data
+    region: us-east
+    linode_id: 123
+`))
+	require.NoError(t, err)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123"),
+		httpmock.NewStringResponder(http.StatusNotFound, `{"errors": [{"reason": "Not found"}]}`))
+
+	_, err = client.PlanStack(context.Background(), manifest)
+	assert.ErrorContains(t, err, "volume data references missing linode_id 123")
+	assert.True(t, linodego.IsNotFound(err))
+}
+
+func TestStackManifest_DeleteInstanceWithVolume(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	manifest, err := linodego.ParseStackManifest([]byte(`tags: [stack]`))
+	require.NoError(t, err)
+
+	linodeID := 1
+
+	mockListEndpoint(t, "linode/instances", []linodego.Instance{
+		{ID: 1, Label: "web-1", Tags: []string{"stack"}},
+	})
+	mockListEndpoint(t, "volumes", []linodego.Volume{
+		{ID: 20, Label: "data", LinodeID: &linodeID, Tags: []string{"stack"}},
+		{ID: 21, Label: "data", Tags: []string{"stack"}},
+	})
+	mockListEndpoint(t, "networking/firewalls", []linodego.Firewall{})
+	mockListEndpoint(t, "nodebalancers", []linodego.NodeBalancer{})
+	mockListEndpoint(t, "domains", []linodego.Domain{})
+
+	plan, err := client.PlanStack(context.Background(), manifest)
+	require.NoError(t, err)
+
+	changes := make([]string, len(plan.Changes))
+	for i, change := range plan.Changes {
+		changes[i] = change.String()
+	}
+
+	// Instances should be deleted before their volumes
+	assert.Equal(t, []string{
+		"delete instance web-1",
+		"delete volume data",
+		"delete volume data",
+	}, changes)
+
+	var (
+		lock     sync.Mutex
+		requests []string
+	)
+
+	record := func(req *http.Request) (*http.Response, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		requests = append(requests, req.Method+" "+strings.SplitN(req.URL.Path, "/", 3)[2])
+
+		return httpmock.NewJsonResponse(http.StatusOK, map[string]any{})
+	}
+
+	for _, method := range []string{"POST", "DELETE"} {
+		httpmock.RegisterRegexpResponder(method, mockRequestURL(t, ".*"), record)
+	}
+
+	// Volume 20 is still attached until it is detached
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, `volumes/20$`),
+		func(_ *http.Request) (*http.Response, error) {
+			lock.Lock()
+			defer lock.Unlock()
+
+			volume := linodego.Volume{ID: 20}
+			if !slices.Contains(requests, "POST volumes/20/detach") {
+				volume.LinodeID = &linodeID
+			}
+
+			return httpmock.NewJsonResponse(http.StatusOK, volume)
+		})
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, `volumes/21$`),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, linodego.Volume{ID: 21}))
+
+	report, err := client.ApplyStackPlan(context.Background(), plan)
+	require.NoError(t, err)
+
+	// Changes with identical descriptions should be reported separately
+	assert.Len(t, report.Succeeded(), 3)
+
+	assert.Equal(t, []string{
+		"DELETE linode/instances/1",
+		"POST volumes/20/detach",
+		"DELETE volumes/20",
+		"DELETE volumes/21",
+	}, requests)
+}
@@ -0,0 +1,133 @@
+package unit
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockTeardownResources(t *testing.T) {
+	linodeID := 1
+	nbLabel := "lb"
+
+	mockListEndpoint(t, "tags/e2e", []map[string]any{
+		{"type": "linode", "data": linodego.Instance{ID: 1, Label: "web"}},
+		{"type": "linode", "data": linodego.Instance{ID: 2, Label: "lke-node", LKEClusterID: 50}},
+		{"type": "volume", "data": linodego.Volume{ID: 10, Label: "data", LinodeID: &linodeID}},
+		{"type": "nodebalancer", "data": linodego.NodeBalancer{ID: 20, Label: &nbLabel}},
+		{"type": "lke_cluster", "data": linodego.LKECluster{ID: 50, Label: "k8s"}},
+		{"type": "domain", "data": linodego.Domain{ID: 40, Domain: "example.com"}},
+		{"type": "reserved_ipv4_address", "data": linodego.InstanceIP{Address: "192.0.2.1", LinodeID: 1}},
+		{"type": "reserved_ipv4_address", "data": linodego.InstanceIP{Address: "192.0.2.2", LinodeID: 3}},
+	})
+	mockListEndpoint(t, "networking/firewalls", []linodego.Firewall{
+		{ID: 30, Label: "fw", Tags: []string{"e2e"}},
+		{ID: 31, Label: "other", Tags: []string{"prod"}},
+	})
+	mockListEndpoint(t, "networking/firewalls/30/devices", []linodego.FirewallDevice{
+		{ID: 300, Entity: linodego.FirewallDeviceEntity{ID: 1, Type: linodego.FirewallDeviceLinode}},
+	})
+	mockListEndpoint(t, "nodebalancers/20/configs", []linodego.NodeBalancerConfig{{ID: 200, Port: 80}})
+}
+
+func TestPlanTeardown(t *testing.T) {
+	client := createMockClient(t)
+
+	mockTeardownResources(t)
+
+	plan, _, err := client.TeardownTag(context.Background(), "e2e", &linodego.TeardownOptions{DryRun: true})
+	require.NoError(t, err)
+
+	dependencies := make(map[string][]string, len(plan.Steps))
+	keys := make([]string, len(plan.Steps))
+
+	for i, step := range plan.Steps {
+		keys[i] = step.Key
+		dependencies[step.Key] = step.DependsOn
+	}
+
+	assert.Equal(t, []string{
+		"delete lkecluster 50",
+		"detach volume 10",
+		"remove_device firewall 30/300",
+		"delete nodebalancer_config 20/200",
+		"delete nodebalancer 20",
+		"delete linode 1",
+		"delete volume 10",
+		"delete firewall 30",
+		"delete domain 40",
+		"delete ipaddress 192.0.2.1",
+		"detach ipaddress 192.0.2.2",
+		"delete ipaddress 192.0.2.2",
+	}, keys)
+
+	assert.Equal(t, []string{"delete nodebalancer_config 20/200"}, dependencies["delete nodebalancer 20"])
+	assert.Equal(t, []string{"remove_device firewall 30/300", "detach volume 10"}, dependencies["delete linode 1"])
+	assert.Equal(t, []string{"detach volume 10"}, dependencies["delete volume 10"])
+	assert.Equal(t, []string{"delete lkecluster 50", "remove_device firewall 30/300"}, dependencies["delete firewall 30"])
+	assert.Equal(t, []string{"delete linode 1"}, dependencies["delete ipaddress 192.0.2.1"])
+	assert.Equal(t, []string{"detach ipaddress 192.0.2.2"}, dependencies["delete ipaddress 192.0.2.2"])
+}
+
+func TestTeardownTag(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	mockTeardownResources(t)
+
+	mockListEndpoint(t, "account/events", []linodego.Event{})
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "volumes/10$"),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, linodego.Volume{ID: 10}))
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "lke/clusters/50$"),
+		httpmock.NewStringResponder(http.StatusNotFound, `{"errors": [{"reason": "Not found"}]}`))
+
+	var (
+		lock     sync.Mutex
+		requests []string
+	)
+
+	record := func(req *http.Request) (*http.Response, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		requests = append(requests, req.Method+" "+strings.SplitN(req.URL.Path, "/", 3)[2])
+
+		// Deleting a resource which is already gone should succeed
+		if strings.HasSuffix(req.URL.Path, "domains/40") {
+			return httpmock.NewStringResponse(http.StatusNotFound, `{"errors": [{"reason": "Not found"}]}`), nil
+		}
+
+		return httpmock.NewJsonResponse(http.StatusOK, map[string]any{})
+	}
+
+	for _, method := range []string{"POST", "DELETE"} {
+		httpmock.RegisterRegexpResponder(method, mockRequestURL(t, ".*"), record)
+	}
+
+	_, report, err := client.TeardownTag(context.Background(), "e2e", &linodego.TeardownOptions{Concurrency: 1})
+	require.NoError(t, err)
+	require.NoError(t, report.Err())
+
+	assert.Equal(t, []string{
+		"DELETE lke/clusters/50",
+		"POST volumes/10/detach",
+		"DELETE networking/firewalls/30/devices/300",
+		"DELETE nodebalancers/20/configs/200",
+		"DELETE nodebalancers/20",
+		"DELETE linode/instances/1",
+		"DELETE volumes/10",
+		"DELETE networking/firewalls/30",
+		"DELETE domains/40",
+		"DELETE networking/reserved/ips/192.0.2.1",
+		"DELETE linode/instances/3/ips/192.0.2.2",
+		"DELETE networking/reserved/ips/192.0.2.2",
+	}, requests)
+}
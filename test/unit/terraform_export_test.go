@@ -0,0 +1,141 @@
+package unit
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportTerraform(t *testing.T) {
+	client := createMockClient(t)
+
+	linodeID := 1
+
+	mockListEndpoint(t, "linode/instances", []linodego.Instance{
+		{ID: 1, Label: "web-1", Region: "us-east", Type: "g6-nanode-1", Tags: []string{"web"}},
+		{ID: 2, Label: "lke-node", Region: "us-east", Type: "g6-standard-2", LKEClusterID: 5},
+	})
+	mockListEndpoint(t, "linode/instances/1/disks", []linodego.InstanceDisk{
+		{ID: 11, Label: "boot", Size: 25000, Filesystem: linodego.FilesystemExt4},
+	})
+	mockListEndpoint(t, "linode/instances/1/configs", []linodego.InstanceConfig{
+		{
+			ID:         12,
+			Label:      "default",
+			Kernel:     "linode/grub2",
+			RootDevice: "/dev/sda",
+			Devices: &linodego.InstanceConfigDeviceMap{
+				SDA: &linodego.InstanceConfigDevice{DiskID: 11},
+				SDB: &linodego.InstanceConfigDevice{VolumeID: 20},
+			},
+		},
+	})
+	mockListEndpoint(t, "volumes", []linodego.Volume{
+		{ID: 20, Label: "data", Region: "us-east", Size: 20, LinodeID: &linodeID},
+	})
+	mockListEndpoint(t, "networking/firewalls", []linodego.Firewall{
+		{
+			ID:    30,
+			Label: "web-fw",
+			Rules: linodego.FirewallRules{
+				InboundPolicy:  "DROP",
+				OutboundPolicy: "ACCEPT",
+				Inbound: []linodego.FirewallRuleInbound{
+					{
+						Label:     "allow-http",
+						Action:    "ACCEPT",
+						Protocol:  linodego.TCP,
+						Ports:     "80,443",
+						Addresses: linodego.NetworkAddresses{IPv4: []string{"0.0.0.0/0"}},
+					},
+				},
+			},
+			Entities: []linodego.FirewallDeviceEntity{
+				{ID: 1, Type: linodego.FirewallDeviceLinode},
+				{ID: 99, Type: linodego.FirewallDeviceNodeBalancer},
+			},
+		},
+	})
+	mockListEndpoint(t, "domains", []linodego.Domain{
+		{ID: 40, Domain: "example.com", Type: linodego.DomainTypeMaster, SOAEmail: "admin@example.com"},
+	})
+	mockListEndpoint(t, "domains/40/records", []linodego.DomainRecord{
+		{ID: 41, Type: linodego.RecordTypeA, Name: "www", Target: "192.0.2.1"},
+	})
+
+	var buf bytes.Buffer
+
+	err := client.ExportTerraform(context.Background(), &buf, &linodego.TerraformExportOptions{
+		ResourceTypes: []string{
+			"linode_instance",
+			"linode_instance_disk",
+			"linode_instance_config",
+			"linode_volume",
+			"linode_firewall",
+			"linode_domain",
+			"linode_domain_record",
+		},
+	})
+	require.NoError(t, err)
+
+	output := buf.String()
+
+	assert.Contains(t, output, `resource "linode_instance" "web_1" {
+  label  = "web-1"
+  region = "us-east"
+  type   = "g6-nanode-1"
+  tags   = ["web"]
+}
+
+import {
+  to = linode_instance.web_1
+  id = "1"
+}`)
+
+	assert.Contains(t, output, `import {
+  to = linode_instance_disk.web_1_boot
+  id = "1,11"
+}`)
+
+	assert.Contains(t, output, `  device {
+    device_name = "sda"
+    disk_id     = linode_instance_disk.web_1_boot.id
+  }
+
+  device {
+    device_name = "sdb"
+    volume_id   = linode_volume.data.id
+  }`)
+
+	assert.Contains(t, output, `linode_id = linode_instance.web_1.id`)
+
+	assert.Contains(t, output, `  inbound {
+    label    = "allow-http"
+    action   = "ACCEPT"
+    protocol = "TCP"
+    ports    = "80,443"
+    ipv4     = ["0.0.0.0/0"]
+  }`)
+
+	// NodeBalancers weren't exported, so their IDs are referenced literally
+	assert.Contains(t, output, `linodes       = [linode_instance.web_1.id]
+  nodebalancers = [99]`)
+
+	assert.Contains(t, output, `domain_id   = linode_domain.example_com.id`)
+	assert.Contains(t, output, `id = "40,41"`)
+
+	assert.NotContains(t, output, "lke-node")
+}
+
+func TestExportTerraform_UnsupportedType(t *testing.T) {
+	client := createMockClient(t)
+
+	err := client.ExportTerraform(context.Background(), &bytes.Buffer{}, &linodego.TerraformExportOptions{
+		ResourceTypes: []string{"linode_rdns"},
+	})
+	assert.ErrorContains(t, err, "unsupported Terraform resource type linode_rdns")
+}
@@ -2,6 +2,7 @@ package unit
 
 import (
 	"fmt"
+	"net/http"
 	"net/url"
 	"regexp"
 	"testing"
@@ -19,6 +20,17 @@ func mockRequestURL(t *testing.T, path string) *regexp.Regexp {
 	return testutil.MockRequestURL(path)
 }
 
+// mockListEndpoint serves the given items as a single page for the given list endpoint.
+func mockListEndpoint[T any](t *testing.T, path string, items []T) {
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, path+`(\?.*)?$`),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, map[string]any{
+			"data":    items,
+			"page":    1,
+			"pages":   1,
+			"results": len(items),
+		}))
+}
+
 func createMockClient(t *testing.T) *linodego.Client {
 	return testutil.CreateMockClientWithError(t, linodego.NewClient)
 }
@@ -0,0 +1,218 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/linode/linodego/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForInstancesStatus(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	var (
+		lock      sync.Mutex
+		requested [][]int
+	)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances"),
+		func(req *http.Request) (*http.Response, error) {
+			var filter map[string][]map[string]int
+			require.NoError(t, json.Unmarshal([]byte(req.Header.Get("X-Filter")), &filter))
+
+			lock.Lock()
+			defer lock.Unlock()
+
+			ids := make([]int, 0)
+			instances := make([]linodego.Instance, 0)
+
+			for _, node := range filter["+or"] {
+				ids = append(ids, node["id"])
+
+				// Simulate a deleted instance
+				if node["id"] == 3 {
+					continue
+				}
+
+				status := linodego.InstanceProvisioning
+				if node["id"] == 1 || len(requested) > 0 {
+					status = linodego.InstanceRunning
+				}
+
+				instances = append(instances, linodego.Instance{ID: node["id"], Status: status})
+			}
+
+			requested = append(requested, ids)
+
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+				"data":    instances,
+				"page":    1,
+				"pages":   1,
+				"results": len(instances),
+			})
+		})
+
+	results, err := client.WaitForInstancesStatus(waitTestContext(t, time.Second), []int{1, 2, 3, 2}, linodego.InstanceRunning)
+
+	var waitErr *linodego.MultiWaitError
+	require.ErrorAs(t, err, &waitErr)
+	assert.Len(t, waitErr.Errors, 1)
+	assert.True(t, linodego.IsResourceGone(waitErr.Errors[3]))
+
+	require.Len(t, results, 3)
+	assert.Equal(t, 1, results[0].ID)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, 2, results[1].ID)
+	assert.NoError(t, results[1].Err)
+	assert.Equal(t, linodego.InstanceRunning, results[1].Value.Status)
+	assert.Equal(t, 3, results[2].ID)
+	assert.Nil(t, results[2].Value)
+
+	// Only unsettled instances should be polled
+	assert.Equal(t, [][]int{{1, 2, 3}, {2}}, requested)
+}
+
+func TestWaitForMany_Timeout(t *testing.T) {
+	client := createMockClient(t)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "volumes"),
+		func(_ *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+				"data": []linodego.Volume{
+					{ID: 1, Status: linodego.VolumeActive},
+					{ID: 2, Status: linodego.VolumeCreating},
+				},
+				"page":    1,
+				"pages":   1,
+				"results": 2,
+			})
+		})
+
+	var settled []int
+
+	results, err := linodego.WaitForMany(context.Background(), client, []int{1, 2}, client.ListVolumes,
+		func(volume linodego.Volume) int { return volume.ID },
+		func(volume linodego.Volume) (bool, error) {
+			return volume.Status == linodego.VolumeActive, nil
+		},
+		&linodego.MultiWaitOptions[linodego.Volume]{
+			Interval: time.Millisecond,
+			Timeout:  20 * time.Millisecond,
+			OnSettled: func(result linodego.MultiWaitResult[linodego.Volume]) {
+				settled = append(settled, result.ID)
+			},
+		},
+	)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Equal(t, []int{1, 2}, settled)
+
+	require.Len(t, results, 2)
+	assert.NoError(t, results[0].Err)
+	assert.ErrorIs(t, results[1].Err, context.DeadlineExceeded)
+	assert.Equal(t, linodego.VolumeCreating, results[1].Value.Status)
+}
+
+func TestWaitForInstancesStatus_Chunked(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	var (
+		lock       sync.Mutex
+		chunkSizes []int
+	)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances"),
+		func(req *http.Request) (*http.Response, error) {
+			var filter map[string][]map[string]int
+			require.NoError(t, json.Unmarshal([]byte(req.Header.Get("X-Filter")), &filter))
+
+			instances := make([]linodego.Instance, 0, len(filter["+or"]))
+			for _, node := range filter["+or"] {
+				instances = append(instances, linodego.Instance{ID: node["id"], Status: linodego.InstanceRunning})
+			}
+
+			lock.Lock()
+			chunkSizes = append(chunkSizes, len(instances))
+			lock.Unlock()
+
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+				"data":    instances,
+				"page":    1,
+				"pages":   1,
+				"results": len(instances),
+			})
+		})
+
+	ids := make([]int, 250)
+	for i := range ids {
+		ids[i] = i + 1
+	}
+
+	results, err := client.WaitForInstancesStatus(context.Background(), ids, linodego.InstanceRunning)
+	require.NoError(t, err)
+	require.Len(t, results, 250)
+
+	assert.Equal(t, []int{100, 100, 50}, chunkSizes)
+}
+
+func TestWaitForMany_RetryOn(t *testing.T) {
+	client := createMockClient(t)
+
+	var requests int
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "volumes"),
+		func(_ *http.Request) (*http.Response, error) {
+			requests++
+
+			if requests == 1 {
+				return httpmock.NewStringResponse(http.StatusBadRequest, `{"errors": [{"reason": "Bad request"}]}`), nil
+			}
+
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+				"data":    []linodego.Volume{{ID: 1, Status: linodego.VolumeActive}},
+				"page":    1,
+				"pages":   1,
+				"results": 1,
+			})
+		})
+
+	wait := func(opts *linodego.MultiWaitOptions[linodego.Volume]) ([]linodego.MultiWaitResult[linodego.Volume], error) {
+		return linodego.WaitForMany(waitTestContext(t, time.Second), client, []int{1}, client.ListVolumes,
+			func(volume linodego.Volume) int { return volume.ID },
+			func(volume linodego.Volume) (bool, error) {
+				return volume.Status == linodego.VolumeActive, nil
+			},
+			opts,
+		)
+	}
+
+	// List errors are retried by default
+	results, err := wait(&linodego.MultiWaitOptions[linodego.Volume]{Interval: time.Millisecond})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, 2, requests)
+
+	// List errors which aren't retried end the wait
+	requests = 0
+
+	results, err = wait(&linodego.MultiWaitOptions[linodego.Volume]{
+		Interval: time.Millisecond,
+		RetryOn: func(err error) bool {
+			return !linodego.ErrHasStatus(err, http.StatusBadRequest)
+		},
+	})
+	require.Error(t, err)
+	assert.True(t, linodego.ErrHasStatus(results[0].Err, http.StatusBadRequest))
+	assert.Equal(t, 1, requests)
+}
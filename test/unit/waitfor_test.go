@@ -2,8 +2,11 @@ package unit
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"regexp"
+	"slices"
 	"testing"
 	"time"
 
@@ -134,6 +137,115 @@ func TestEventPollerWaitForFinished(t *testing.T) {
 	}
 }
 
+func TestWaitForProgressAndBackoff(t *testing.T) {
+	client := createMockClient(t)
+
+	statuses := []linodego.InstanceStatus{
+		linodego.InstanceProvisioning,
+		linodego.InstanceProvisioning,
+		linodego.InstanceBooting,
+		linodego.InstanceRunning,
+	}
+
+	step := 0
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "linode/instances/123"),
+		func(_ *http.Request) (*http.Response, error) {
+			status := statuses[min(step, len(statuses)-1)]
+			step++
+
+			return httpmock.NewJsonResponse(http.StatusOK, linodego.Instance{ID: 123, Status: status})
+		})
+
+	var observed []string
+
+	instance, err := linodego.WaitFor(waitTestContext(t, time.Second), client,
+		func(ctx context.Context) (*linodego.Instance, error) {
+			return client.GetInstance(ctx, 123)
+		},
+		func(instance *linodego.Instance) (bool, error) {
+			return instance.Status == linodego.InstanceRunning, nil
+		},
+		&linodego.WaitOptions[*linodego.Instance]{
+			Interval:      time.Millisecond,
+			BackoffFactor: 2,
+			MaxInterval:   4 * time.Millisecond,
+			Jitter:        0.1,
+			State: func(instance *linodego.Instance) string {
+				return string(instance.Status)
+			},
+			OnStateChange: func(progress linodego.WaitProgress[*linodego.Instance]) {
+				observed = append(observed, fmt.Sprintf("%d:%s", progress.Attempt, progress.State))
+			},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if instance.Status != linodego.InstanceRunning {
+		t.Fatalf("expected instance to be running, got %s", instance.Status)
+	}
+
+	expected := []string{"1:provisioning", "3:booting", "4:running"}
+	if !slices.Equal(observed, expected) {
+		t.Fatalf("expected state changes %v, got %v", expected, observed)
+	}
+}
+
+func TestWaitForResourceGone(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "volumes/123"),
+		httpmock.NewStringResponder(http.StatusNotFound, `{"errors": [{"reason": "Not found"}]}`))
+
+	_, err := linodego.WaitFor(waitTestContext(t, time.Second), client,
+		func(ctx context.Context) (*linodego.Volume, error) {
+			return client.GetVolume(ctx, 123)
+		},
+		nil,
+		nil,
+	)
+	if !linodego.IsResourceGone(err) {
+		t.Fatalf("expected resource gone error, got %v", err)
+	}
+
+	// The original API error should remain inspectable
+	var apiErr *linodego.Error
+	if !errors.As(err, &apiErr) || apiErr.Code != http.StatusNotFound {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+
+	// The WaitFor* helpers should continue to return the API error as-is
+	_, err = client.WaitForVolumeStatus(waitTestContext(t, time.Second), 123, linodego.VolumeActive)
+	if _, ok := err.(*linodego.Error); !ok || !linodego.IsNotFound(err) { //nolint:errorlint // the concrete type is checked
+		t.Fatalf("expected *linodego.Error, got %T: %v", err, err)
+	}
+}
+
+func TestWaitForTimeout(t *testing.T) {
+	client := createMockClient(t)
+	client.SetPollDelay(time.Millisecond)
+
+	httpmock.RegisterRegexpResponder("GET", mockRequestURL(t, "volumes/123"),
+		func(_ *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(http.StatusOK, linodego.Volume{ID: 123, Status: linodego.VolumeCreating})
+		})
+
+	_, err := linodego.WaitFor(context.Background(), client,
+		func(ctx context.Context) (*linodego.Volume, error) {
+			return client.GetVolume(ctx, 123)
+		},
+		func(volume *linodego.Volume) (bool, error) {
+			return volume.Status == linodego.VolumeActive, nil
+		},
+		&linodego.WaitOptions[*linodego.Volume]{Timeout: 10 * time.Millisecond},
+	)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
 func waitTestContext(t *testing.T, timeout time.Duration) context.Context {
 	t.Helper()
 
@@ -64,40 +64,12 @@ const (
 func (c *Client) ListTypes(ctx context.Context, opts *ListOptions) ([]LinodeType, error) {
 	e := "linode/types"
 
-	endpoint, err := generateListCacheURL(e, opts)
-	if err != nil {
-		return nil, err
-	}
-
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]LinodeType), nil
-	}
-
-	response, err := getPaginatedResults[LinodeType](ctx, c, e, opts)
-	if err != nil {
-		return nil, err
-	}
-
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
-
-	return response, nil
+	return getCachedPaginatedResults[LinodeType](ctx, c, e, opts, &cacheExpiryTime)
 }
 
 // GetType gets the type with the provided ID. This endpoint is cached by default.
 func (c *Client) GetType(ctx context.Context, typeID string) (*LinodeType, error) {
 	e := formatAPIPath("linode/types/%s", url.PathEscape(typeID))
 
-	if result := c.getCachedResponse(e); result != nil {
-		result := result.(LinodeType)
-		return &result, nil
-	}
-
-	response, err := doGETRequest[LinodeType](ctx, c, e)
-	if err != nil {
-		return nil, err
-	}
-
-	c.addCachedResponse(e, response, &cacheExpiryTime)
-
-	return response, nil
+	return doCachedGETRequest[LinodeType](ctx, c, e, &cacheExpiryTime)
 }
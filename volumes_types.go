@@ -25,21 +25,5 @@ type VolumeTypeRegionPrice struct {
 func (c *Client) ListVolumeTypes(ctx context.Context, opts *ListOptions) ([]VolumeType, error) {
 	e := "volumes/types"
 
-	endpoint, err := generateListCacheURL(e, opts)
-	if err != nil {
-		return nil, err
-	}
-
-	if result := c.getCachedResponse(endpoint); result != nil {
-		return result.([]VolumeType), nil
-	}
-
-	response, err := getPaginatedResults[VolumeType](ctx, c, e, opts)
-	if err != nil {
-		return nil, err
-	}
-
-	c.addCachedResponse(endpoint, response, &cacheExpiryTime)
-
-	return response, nil
+	return getCachedPaginatedResults[VolumeType](ctx, c, e, opts, &cacheExpiryTime)
 }
@@ -2,8 +2,10 @@ package linodego
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"math/rand/v2"
 	"net/http"
 	"slices"
 	"strconv"
@@ -33,17 +35,16 @@ type EventPoller struct {
 // WaitForInstanceStatus waits for the Linode instance to reach the desired state
 // before returning.
 func (client Client) WaitForInstanceStatus(ctx context.Context, instanceID int, status InstanceStatus) (*Instance, error) {
-	return poll(ctx, &client,
-		func(ctx context.Context) (*Instance, bool, error) {
-			instance, err := client.GetInstance(ctx, instanceID)
-			if err != nil {
-				return instance, false, err
-			}
-
-			return instance, instance.Status == status, nil
+	return waitFor(ctx, &client,
+		func(ctx context.Context) (*Instance, error) {
+			return client.GetInstance(ctx, instanceID)
+		},
+		func(instance *Instance) (bool, error) {
+			return instance.Status == status, nil
 		},
-		func() error {
-			return fmt.Errorf("Error waiting for Instance %d status %s: %w", instanceID, status, ctx.Err())
+		nil,
+		func(err error) error {
+			return fmt.Errorf("Error waiting for Instance %d status %s: %w", instanceID, status, err)
 		},
 	)
 }
@@ -51,28 +52,28 @@ func (client Client) WaitForInstanceStatus(ctx context.Context, instanceID int,
 // WaitForInstanceDiskStatus waits for the Linode instance disk to reach the desired state
 // before returning.
 func (client Client) WaitForInstanceDiskStatus(ctx context.Context, instanceID int, diskID int, status DiskStatus) (*InstanceDisk, error) {
-	return poll(ctx, &client,
-		func(ctx context.Context) (*InstanceDisk, bool, error) {
+	return waitFor(ctx, &client,
+		func(ctx context.Context) (*InstanceDisk, error) {
 			// GetInstanceDisk will 404 on newly created disks. Use List instead.
 			disks, err := client.ListInstanceDisks(ctx, instanceID, nil)
 			if err != nil {
-				return nil, false, err
+				return nil, err
 			}
 
 			for _, disk := range disks {
 				if disk.ID == diskID {
-					if disk.Status == status {
-						return &disk, true, nil
-					}
-
-					break
+					return &disk, nil
 				}
 			}
 
-			return nil, false, nil
+			return nil, nil
+		},
+		func(disk *InstanceDisk) (bool, error) {
+			return disk != nil && disk.Status == status, nil
 		},
-		func() error {
-			return fmt.Errorf("Error waiting for Instance %d Disk %d status %s: %w", instanceID, diskID, status, ctx.Err())
+		nil,
+		func(err error) error {
+			return fmt.Errorf("Error waiting for Instance %d Disk %d status %s: %w", instanceID, diskID, status, err)
 		},
 	)
 }
@@ -80,17 +81,16 @@ func (client Client) WaitForInstanceDiskStatus(ctx context.Context, instanceID i
 // WaitForVolumeStatus waits for the Volume to reach the desired state
 // before returning.
 func (client Client) WaitForVolumeStatus(ctx context.Context, volumeID int, status VolumeStatus) (*Volume, error) {
-	return poll(ctx, &client,
-		func(ctx context.Context) (*Volume, bool, error) {
-			volume, err := client.GetVolume(ctx, volumeID)
-			if err != nil {
-				return volume, false, err
-			}
-
-			return volume, volume.Status == status, nil
+	return waitFor(ctx, &client,
+		func(ctx context.Context) (*Volume, error) {
+			return client.GetVolume(ctx, volumeID)
 		},
-		func() error {
-			return fmt.Errorf("Error waiting for Volume %d status %s: %w", volumeID, status, ctx.Err())
+		func(volume *Volume) (bool, error) {
+			return volume.Status == status, nil
+		},
+		nil,
+		func(err error) error {
+			return fmt.Errorf("Error waiting for Volume %d status %s: %w", volumeID, status, err)
 		},
 	)
 }
@@ -103,17 +103,16 @@ func (client Client) WaitForSnapshotStatus(
 	snapshotID int,
 	status InstanceSnapshotStatus,
 ) (*InstanceSnapshot, error) {
-	return poll(ctx, &client,
-		func(ctx context.Context) (*InstanceSnapshot, bool, error) {
-			snapshot, err := client.GetInstanceSnapshot(ctx, instanceID, snapshotID)
-			if err != nil {
-				return snapshot, false, err
-			}
-
-			return snapshot, snapshot.Status == status, nil
+	return waitFor(ctx, &client,
+		func(ctx context.Context) (*InstanceSnapshot, error) {
+			return client.GetInstanceSnapshot(ctx, instanceID, snapshotID)
+		},
+		func(snapshot *InstanceSnapshot) (bool, error) {
+			return snapshot.Status == status, nil
 		},
-		func() error {
-			return fmt.Errorf("Error waiting for Instance %d Snapshot %d status %s: %w", instanceID, snapshotID, status, ctx.Err())
+		nil,
+		func(err error) error {
+			return fmt.Errorf("Error waiting for Instance %d Snapshot %d status %s: %w", instanceID, snapshotID, status, err)
 		},
 	)
 }
@@ -122,26 +121,25 @@ func (client Client) WaitForSnapshotStatus(
 // before returning. An active Instance will not immediately attach or detach a volume, so
 // the LinodeID must be polled to determine volume readiness from the API.
 func (client Client) WaitForVolumeLinodeID(ctx context.Context, volumeID int, linodeID *int) (*Volume, error) {
-	return poll(ctx, &client,
-		func(ctx context.Context) (*Volume, bool, error) {
-			volume, err := client.GetVolume(ctx, volumeID)
-			if err != nil {
-				return volume, false, err
-			}
-
+	return waitFor(ctx, &client,
+		func(ctx context.Context) (*Volume, error) {
+			return client.GetVolume(ctx, volumeID)
+		},
+		func(volume *Volume) (bool, error) {
 			switch {
 			case linodeID == nil && volume.LinodeID == nil:
-				return volume, true, nil
+				return true, nil
 			case linodeID == nil || volume.LinodeID == nil:
 				// Continue waiting.
 			case *volume.LinodeID == *linodeID:
-				return volume, true, nil
+				return true, nil
 			}
 
-			return volume, false, nil
+			return false, nil
 		},
-		func() error {
-			return fmt.Errorf("Error waiting for Volume %d to have Instance %v: %w", volumeID, linodeID, ctx.Err())
+		nil,
+		func(err error) error {
+			return fmt.Errorf("Error waiting for Volume %d to have Instance %v: %w", volumeID, linodeID, err)
 		},
 	)
 }
@@ -149,17 +147,16 @@ func (client Client) WaitForVolumeLinodeID(ctx context.Context, volumeID int, li
 // WaitForLKEClusterStatus waits for the LKECluster to reach the desired state
 // before returning.
 func (client Client) WaitForLKEClusterStatus(ctx context.Context, clusterID int, status LKEClusterStatus) (*LKECluster, error) {
-	return poll(ctx, &client,
-		func(ctx context.Context) (*LKECluster, bool, error) {
-			cluster, err := client.GetLKECluster(ctx, clusterID)
-			if err != nil {
-				return cluster, false, err
-			}
-
-			return cluster, cluster.Status == status, nil
+	return waitFor(ctx, &client,
+		func(ctx context.Context) (*LKECluster, error) {
+			return client.GetLKECluster(ctx, clusterID)
 		},
-		func() error {
-			return fmt.Errorf("Error waiting for Cluster %d status %s: %w", clusterID, status, ctx.Err())
+		func(cluster *LKECluster) (bool, error) {
+			return cluster.Status == status, nil
+		},
+		nil,
+		func(err error) error {
+			return fmt.Errorf("Error waiting for Cluster %d status %s: %w", clusterID, status, err)
 		},
 	)
 }
@@ -196,32 +193,30 @@ func (client Client) WaitForLKEClusterConditions(
 		return fmt.Errorf("failed to get Kubeconfig for LKE cluster %d: %w", clusterID, err)
 	}
 
-	ticker := newTicker(&client)
-	defer ticker.Stop()
-
 	conditionOptions := ClusterConditionOptions{LKEClusterKubeconfig: lkeKubeConfig, TransportWrapper: options.TransportWrapper}
 
-	for _, condition := range conditions {
-	ConditionSucceeded:
-		for {
-			select {
-			case <-ticker.C:
-				result, err := condition(ctx, conditionOptions)
-				if err != nil {
-					log.Printf("[WARN] Ignoring WaitForLKEClusterConditions conditional error: %s", err)
-
-					if !options.Retry {
-						return err
-					}
-				}
-
-				if result {
-					break ConditionSucceeded
-				}
+	waitOptions := &WaitOptions[bool]{
+		RetryOn: func(err error) bool {
+			log.Printf("[WARN] Ignoring WaitForLKEClusterConditions conditional error: %s", err)
+			return options.Retry
+		},
+	}
 
-			case <-ctx.Done():
-				return fmt.Errorf("Error waiting for cluster %d conditions: %w", clusterID, ctx.Err())
-			}
+	for _, condition := range conditions {
+		_, err := waitFor(ctx, &client,
+			func(ctx context.Context) (bool, error) {
+				return condition(ctx, conditionOptions)
+			},
+			func(result bool) (bool, error) {
+				return result, nil
+			},
+			waitOptions,
+			func(err error) error {
+				return fmt.Errorf("Error waiting for cluster %d conditions: %w", clusterID, err)
+			},
+		)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -269,64 +264,29 @@ func (client Client) WaitForEventFinished(
 		log.Printf("[INFO] Waiting %d seconds for %s events since %v for %s %v", int(time.Until(deadline).Seconds()), action, minStart, titledEntityType, id)
 	}
 
-	ticker := newTicker(&client)
-
-	// avoid repeating log messages
-	nextLog := ""
-	lastLog := ""
 	lastEventID := 0
 
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ticker.C:
-			if lastEventID > 0 {
-				filter.AddField(Gte, "id", lastEventID)
-			}
-
+	return waitFor(ctx, &client,
+		func(ctx context.Context) (*Event, error) {
 			filterStr, err := filter.MarshalJSON()
 			if err != nil {
 				return nil, err
 			}
 
-			listOptions := NewListOptions(pages, string(filterStr))
-
-			events, err := client.ListEvents(ctx, listOptions)
+			events, err := client.ListEvents(ctx, NewListOptions(pages, string(filterStr)))
 			if err != nil {
 				return nil, err
 			}
 
+			var latest *Event
+
 			// If there are events for this instance + action, inspect them
 			for _, event := range events {
 				if event.Entity == nil || event.Entity.Type != entityType {
-					// log.Println("type mismatch", event.Entity.Type, entityType)
 					continue
 				}
 
-				var entID string
-
-				switch id := event.Entity.ID.(type) {
-				case float64, float32:
-					entID = fmt.Sprintf("%.f", id)
-				case int:
-					entID = strconv.Itoa(id)
-				default:
-					entID = fmt.Sprintf("%v", id)
-				}
-
-				var findID string
-
-				switch id := id.(type) {
-				case float64, float32:
-					findID = fmt.Sprintf("%.f", id)
-				case int:
-					findID = strconv.Itoa(id)
-				default:
-					findID = fmt.Sprintf("%v", id)
-				}
-
-				if entID != findID {
-					// log.Println("id mismatch", entID, findID)
+				if formatEventEntityID(event.Entity.ID) != formatEventEntityID(id) {
 					continue
 				}
 
@@ -337,44 +297,77 @@ func (client Client) WaitForEventFinished(
 				// This is the event we are looking for. Save our place.
 				if lastEventID == 0 {
 					lastEventID = event.ID
+					filter.AddField(Gte, "id", lastEventID)
 				}
 
-				switch event.Status {
-				case EventFailed:
-					return &event, fmt.Errorf("%s %v action %s failed", titledEntityType, id, action)
-				case EventFinished:
-					log.Printf("[INFO] %s %v action %s is finished", titledEntityType, id, action)
+				if event.Status == EventFailed || event.Status == EventFinished {
 					return &event, nil
 				}
 
-				nextLog = fmt.Sprintf("[INFO] %s %v action %s is %s", titledEntityType, id, action, event.Status)
+				if latest == nil {
+					latest = &event
+				}
 			}
 
-			// de-dupe logging statements
-			if nextLog != lastLog {
-				log.Print(nextLog)
-				lastLog = nextLog
+			return latest, nil
+		},
+		func(event *Event) (bool, error) {
+			switch {
+			case event == nil:
+				return false, nil
+			case event.Status == EventFailed:
+				return false, fmt.Errorf("%s %v action %s failed", titledEntityType, id, action)
+			default:
+				return event.Status == EventFinished, nil
 			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Event Status '%s' of %s %v action '%s': %w", EventFinished, titledEntityType, id, action, ctx.Err())
-		}
+		},
+		&WaitOptions[*Event]{
+			// avoid repeating log messages
+			State: func(event *Event) string {
+				if event == nil {
+					return ""
+				}
+
+				return string(event.Status)
+			},
+			OnStateChange: func(progress WaitProgress[*Event]) {
+				if progress.State != "" {
+					log.Printf("[INFO] %s %v action %s is %s", titledEntityType, id, action, progress.State)
+				}
+			},
+		},
+		func(err error) error {
+			return fmt.Errorf("Error waiting for Event Status '%s' of %s %v action '%s': %w", EventFinished, titledEntityType, id, action, err)
+		},
+	)
+}
+
+// formatEventEntityID formats the given entity ID so that IDs decoded
+// as floats can be compared to IDs given as ints or strings.
+func formatEventEntityID(id any) string {
+	switch id := id.(type) {
+	case float64, float32:
+		return fmt.Sprintf("%.f", id)
+	case int:
+		return strconv.Itoa(id)
+	default:
+		return fmt.Sprintf("%v", id)
 	}
 }
 
 // WaitForImageStatus waits for the Image to reach the desired state
 // before returning.
 func (client Client) WaitForImageStatus(ctx context.Context, imageID string, status ImageStatus) (*Image, error) {
-	return poll(ctx, &client,
-		func(ctx context.Context) (*Image, bool, error) {
-			image, err := client.GetImage(ctx, imageID)
-			if err != nil {
-				return image, false, err
-			}
-
-			return image, image.Status == status, nil
+	return waitFor(ctx, &client,
+		func(ctx context.Context) (*Image, error) {
+			return client.GetImage(ctx, imageID)
+		},
+		func(image *Image) (bool, error) {
+			return image.Status == status, nil
 		},
-		func() error {
-			return fmt.Errorf("failed to wait for Image %s status %s: %w", imageID, status, ctx.Err())
+		nil,
+		func(err error) error {
+			return fmt.Errorf("failed to wait for Image %s status %s: %w", imageID, status, err)
 		},
 	)
 }
@@ -382,13 +375,11 @@ func (client Client) WaitForImageStatus(ctx context.Context, imageID string, sta
 // WaitForImageRegionStatus waits for an Image's replica to reach the desired state
 // before returning.
 func (client Client) WaitForImageRegionStatus(ctx context.Context, imageID, region string, status ImageRegionStatus) (*Image, error) {
-	return poll(ctx, &client,
-		func(ctx context.Context) (*Image, bool, error) {
-			image, err := client.GetImage(ctx, imageID)
-			if err != nil {
-				return image, false, err
-			}
-
+	return waitFor(ctx, &client,
+		func(ctx context.Context) (*Image, error) {
+			return client.GetImage(ctx, imageID)
+		},
+		func(image *Image) (bool, error) {
 			replicaIdx := slices.IndexFunc(
 				image.Regions,
 				func(r ImageRegion) bool {
@@ -396,14 +387,11 @@ func (client Client) WaitForImageRegionStatus(ctx context.Context, imageID, regi
 				},
 			)
 
-			if replicaIdx < 0 || image.Regions[replicaIdx].Status != status {
-				return image, false, nil
-			}
-
-			return image, true, nil
+			return replicaIdx >= 0 && image.Regions[replicaIdx].Status == status, nil
 		},
-		func() error {
-			return fmt.Errorf("failed to wait for Image %s status %s: %w", imageID, status, ctx.Err())
+		nil,
+		func(err error) error {
+			return fmt.Errorf("failed to wait for Image %s status %s: %w", imageID, status, err)
 		},
 	)
 }
@@ -433,22 +421,26 @@ var databaseStatusHandlers = map[DatabaseEngineType]databaseStatusFunc{
 func (client Client) WaitForDatabaseStatus(
 	ctx context.Context, dbID int, dbEngine DatabaseEngineType, status DatabaseStatus,
 ) error {
-	_, err := poll(ctx, &client,
-		func(ctx context.Context) (struct{}, bool, error) {
-			statusHandler, ok := databaseStatusHandlers[dbEngine]
-			if !ok {
-				return struct{}{}, false, fmt.Errorf("invalid db engine: %s", dbEngine)
-			}
+	statusHandler, ok := databaseStatusHandlers[dbEngine]
+	if !ok {
+		return fmt.Errorf("invalid db engine: %s", dbEngine)
+	}
 
+	_, err := waitFor(ctx, &client,
+		func(ctx context.Context) (DatabaseStatus, error) {
 			currentStatus, err := statusHandler(ctx, client, dbID)
 			if err != nil {
-				return struct{}{}, false, fmt.Errorf("failed to get db status: %w", err)
+				return "", fmt.Errorf("failed to get db status: %w", err)
 			}
 
-			return struct{}{}, currentStatus == status, nil
+			return currentStatus, nil
+		},
+		func(currentStatus DatabaseStatus) (bool, error) {
+			return currentStatus == status, nil
 		},
-		func() error {
-			return fmt.Errorf("failed to wait for database %d status: %w", dbID, ctx.Err())
+		nil,
+		func(err error) error {
+			return fmt.Errorf("failed to wait for database %d status: %w", dbID, err)
 		},
 	)
 
@@ -512,9 +504,21 @@ func (client Client) NewEventPollerWithoutEntity(entityType EntityType, action E
 
 // WaitForLatestUnknownEvent waits for the next event not observed by this poller.
 func (p *EventPoller) WaitForLatestUnknownEvent(ctx context.Context) (*Event, error) {
-	ticker := newTicker(&p.client)
-	defer ticker.Stop()
+	return waitFor(ctx, &p.client,
+		p.nextUnknownEvent,
+		func(event *Event) (bool, error) {
+			return event != nil, nil
+		},
+		nil,
+		func(err error) error {
+			return fmt.Errorf("failed to wait for event: %w", err)
+		},
+	)
+}
 
+// nextUnknownEvent returns the latest event not yet observed by this poller,
+// or nil if there is no such event.
+func (p *EventPoller) nextUnknownEvent(ctx context.Context) (*Event, error) {
 	f := Filter{
 		OrderBy: "created",
 		Order:   Descending,
@@ -528,68 +532,64 @@ func (p *EventPoller) WaitForLatestUnknownEvent(ctx context.Context) (*Event, er
 		return nil, err
 	}
 
-	listOpts := ListOptions{
+	events, err := p.client.ListEvents(ctx, &ListOptions{
 		Filter:      string(fBytes),
 		PageOptions: &PageOptions{Page: 1},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
 	}
 
-	for {
-		select {
-		case <-ticker.C:
-			events, err := p.client.ListEvents(ctx, &listOpts)
-			if err != nil {
-				return nil, fmt.Errorf("failed to list events: %w", err)
-			}
-
-			for _, event := range events {
-				if p.SecondaryEntityID != nil && !eventMatchesSecondary(p.SecondaryEntityID, event) {
-					continue
-				}
+	for _, event := range events {
+		if p.SecondaryEntityID != nil && !eventMatchesSecondary(p.SecondaryEntityID, event) {
+			continue
+		}
 
-				if _, ok := p.previousEvents[event.ID]; !ok {
-					// Store this event so it is no longer picked up
-					// on subsequent jobs
-					p.previousEvents[event.ID] = true
+		if _, ok := p.previousEvents[event.ID]; !ok {
+			// Store this event so it is no longer picked up
+			// on subsequent jobs
+			p.previousEvents[event.ID] = true
 
-					return &event, nil
-				}
-			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("failed to wait for event: %w", ctx.Err())
+			return &event, nil
 		}
 	}
+
+	return nil, nil
 }
 
 // WaitForFinished waits for a new event to be finished.
 func (p *EventPoller) WaitForFinished(ctx context.Context) (*Event, error) {
-	ticker := newTicker(&p.client)
-	defer ticker.Stop()
-
 	event, err := p.WaitForLatestUnknownEvent(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to wait for event: %w", err)
 	}
 
-	for {
-		select {
-		case <-ticker.C:
-			event, err = p.client.GetEvent(ctx, event.ID)
+	event, err = waitFor(ctx, &p.client,
+		func(ctx context.Context) (*Event, error) {
+			result, err := p.client.GetEvent(ctx, event.ID)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get event: %w", err)
 			}
 
-			switch event.Status {
-			case EventFinished:
-				return event, nil
-			case EventFailed:
-				return nil, fmt.Errorf("event %d has failed", event.ID)
-			case EventScheduled, EventStarted, EventNotification:
-				continue
+			return result, nil
+		},
+		func(event *Event) (bool, error) {
+			if event.Status == EventFailed {
+				return false, fmt.Errorf("event %d has failed", event.ID)
 			}
-		case <-ctx.Done():
-			return nil, fmt.Errorf("failed to wait for event finished: %w", ctx.Err())
-		}
+
+			return event.Status == EventFinished, nil
+		},
+		nil,
+		func(err error) error {
+			return fmt.Errorf("failed to wait for event finished: %w", err)
+		},
+	)
+	if err != nil {
+		return nil, err
 	}
+
+	return event, nil
 }
 
 // WaitForResourceFree waits for a resource to have no running events.
@@ -608,38 +608,32 @@ func (client Client) WaitForResourceFree(
 		return fmt.Errorf("failed to create filter: %s", err)
 	}
 
-	ticker := newTicker(&client)
-	defer ticker.Stop()
-
-	// A helper function to determine whether a resource is busy
-	checkIsBusy := func(events []Event) bool {
-		for _, event := range events {
-			if event.Status == EventStarted || event.Status == EventScheduled {
-				return true
-			}
-		}
-
-		return false
-	}
-
-	for {
-		select {
-		case <-ticker.C:
+	_, err = waitFor(ctx, &client,
+		func(ctx context.Context) ([]Event, error) {
 			events, err := client.ListEvents(ctx, &ListOptions{
 				Filter: string(filterStr),
 			})
 			if err != nil {
-				return fmt.Errorf("failed to list events: %s", err)
+				return nil, fmt.Errorf("failed to list events: %s", err)
 			}
 
-			if !checkIsBusy(events) {
-				return nil
-			}
+			return events, nil
+		},
+		func(events []Event) (bool, error) {
+			// The resource is busy while any of its events are running
+			busy := slices.ContainsFunc(events, func(event Event) bool {
+				return event.Status == EventStarted || event.Status == EventScheduled
+			})
 
-		case <-ctx.Done():
-			return fmt.Errorf("failed to wait for resource free: %s", ctx.Err())
-		}
-	}
+			return !busy, nil
+		},
+		nil,
+		func(err error) error {
+			return fmt.Errorf("failed to wait for resource free: %s", err)
+		},
+	)
+
+	return err
 }
 
 // eventMatchesSecondary returns whether the given event's secondary entity
@@ -669,17 +663,16 @@ func (client Client) WaitForAlertDefinitionStatus(
 	serviceType string,
 	alertID int,
 ) (*AlertDefinition, error) {
-	return poll(ctx, &client,
-		func(ctx context.Context) (*AlertDefinition, bool, error) {
-			alertDef, err := client.GetMonitorAlertDefinition(ctx, serviceType, alertID)
-			if err != nil {
-				return alertDef, false, err
-			}
-
-			return alertDef, alertDef.Status == status, nil
+	return waitFor(ctx, &client,
+		func(ctx context.Context) (*AlertDefinition, error) {
+			return client.GetMonitorAlertDefinition(ctx, serviceType, alertID)
+		},
+		func(alertDef *AlertDefinition) (bool, error) {
+			return alertDef.Status == status, nil
 		},
-		func() error {
-			return fmt.Errorf("failed to wait for AlertDefinition %d status %s: %w", alertID, status, ctx.Err())
+		nil,
+		func(err error) error {
+			return fmt.Errorf("failed to wait for AlertDefinition %d status %s: %w", alertID, status, err)
 		},
 	)
 }
@@ -691,17 +684,21 @@ func (client Client) WaitForVolumeIOReadyStatus(
 	volumeID int,
 	status bool,
 ) (*Volume, error) {
-	return poll(ctx, &client,
-		func(ctx context.Context) (*Volume, bool, error) {
+	return waitFor(ctx, &client,
+		func(ctx context.Context) (*Volume, error) {
 			volume, err := client.GetVolume(ctx, volumeID)
 			if err != nil {
-				return volume, false, fmt.Errorf("failed to get volume: %w", err)
+				return nil, fmt.Errorf("failed to get volume: %w", err)
 			}
 
-			return volume, volume.IOReady == status, nil
+			return volume, nil
 		},
-		func() error {
-			return fmt.Errorf("failed to wait for Volume %d IO Ready status %t: %w", volumeID, status, ctx.Err())
+		func(volume *Volume) (bool, error) {
+			return volume.IOReady == status, nil
+		},
+		nil,
+		func(err error) error {
+			return fmt.Errorf("failed to wait for Volume %d IO Ready status %t: %w", volumeID, status, err)
 		},
 	)
 }
@@ -740,36 +737,232 @@ func (p *EventPoller) preTask(ctx context.Context) error {
 	return nil
 }
 
-// poll runs check on each tick until check reports done, returns an error, or ctx is canceled.
+// ResourceGoneError is returned when a resource being waited on no longer exists,
+// e.g. because it was deleted by another process.
+type ResourceGoneError struct {
+	// Err is the 404 error returned for the resource, usually an *Error.
+	Err error
+}
+
+func (e *ResourceGoneError) Error() string {
+	return fmt.Sprintf("resource no longer exists: %s", e.Err)
+}
+
+func (e *ResourceGoneError) Unwrap() error {
+	return e.Err
+}
+
+// IsResourceGone reports whether err indicates that the resource being waited on no longer exists.
+func IsResourceGone(err error) bool {
+	var goneErr *ResourceGoneError
+	return errors.As(err, &goneErr)
+}
+
+// WaitCondition reports whether a polled value has reached the desired state.
+// Returning an error stops the wait.
+type WaitCondition[T any] func(value T) (bool, error)
+
+// WaitProgress describes a state observed while waiting.
+type WaitProgress[T any] struct {
+	// Attempt is the number of the poll the state was observed on, starting at 1.
+	Attempt int
+
+	// Elapsed is the duration since the wait started.
+	Elapsed time.Duration
+
+	// State is the observed state as described by WaitOptions.State.
+	State string
+
+	// Value is the polled value.
+	Value T
+}
+
+// WaitOptions configures a call to WaitFor.
+type WaitOptions[T any] struct {
+	// Interval is the initial duration between polls.
+	// Defaults to the client's poll delay.
+	Interval time.Duration
+
+	// BackoffFactor multiplies the interval after each poll.
+	// A value <= 1 polls at a fixed interval.
+	BackoffFactor float64
+
+	// MaxInterval limits the interval when backing off.
+	MaxInterval time.Duration
+
+	// Jitter randomizes each interval by up to the given fraction, e.g. 0.1 for +/- 10%.
+	Jitter float64
+
+	// Timeout limits the total duration of the wait in addition to any context deadline.
+	Timeout time.Duration
+
+	// State describes the state of a polled value, e.g. its status.
+	// If nil, every polled value is considered a state change.
+	State func(value T) string
+
+	// OnStateChange is called whenever the observed state changes,
+	// including the first observed state.
+	OnStateChange func(progress WaitProgress[T])
+
+	// RetryOn reports whether polling should continue after the given error
+	// was returned when getting the value. By default, polling stops on all errors.
+	RetryOn func(err error) bool
+}
+
+// WaitFor polls get until the polled value satisfies the given condition, returning the final value.
+// A nil condition is satisfied by any successfully polled value.
+//
+// If get returns a 404 error that is not retried, a *ResourceGoneError is returned.
+// For example, to wait for an instance to be running with backoff:
+//
+//	instance, err := linodego.WaitFor(ctx, client,
+//		func(ctx context.Context) (*linodego.Instance, error) {
+//			return client.GetInstance(ctx, instanceID)
+//		},
+//		func(instance *linodego.Instance) (bool, error) {
+//			return instance.Status == linodego.InstanceRunning, nil
+//		},
+//		&linodego.WaitOptions[*linodego.Instance]{BackoffFactor: 1.5, MaxInterval: 30 * time.Second},
+//	)
 //
 //nolint:ireturn // false positive: returning a generic concrete type, not an interface
-func poll[T any](
+func WaitFor[T any](
 	ctx context.Context,
 	client *Client,
-	check func(context.Context) (T, bool, error),
-	timeoutErr func() error,
+	get func(ctx context.Context) (T, error),
+	condition WaitCondition[T],
+	opts *WaitOptions[T],
 ) (T, error) {
-	ticker := newTicker(client)
-	defer ticker.Stop()
+	// 404 errors are only wrapped here, as the WaitFor* helpers have always returned them as an *Error
+	getOrGone := func(ctx context.Context) (T, error) {
+		value, err := get(ctx)
+		if IsNotFound(err) {
+			return value, &ResourceGoneError{Err: err}
+		}
+
+		return value, err
+	}
+
+	return waitFor(ctx, client, getOrGone, condition, opts, func(err error) error {
+		return fmt.Errorf("failed to wait for condition: %w", err)
+	})
+}
+
+// waitFor implements WaitFor, building the error returned when the wait is canceled using timeoutErr.
+//
+//nolint:ireturn // false positive: returning a generic concrete type, not an interface
+func waitFor[T any](
+	ctx context.Context,
+	client *Client,
+	get func(ctx context.Context) (T, error),
+	condition WaitCondition[T],
+	opts *WaitOptions[T],
+	timeoutErr func(error) error,
+) (T, error) {
+	if opts == nil {
+		opts = &WaitOptions[T]{}
+	}
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = client.GetPollDelay()
+	}
+
+	timer := time.NewTimer(opts.jitter(interval))
+	defer timer.Stop()
+
+	var (
+		start     = time.Now()
+		lastState string
+		attempt   int
+	)
 
 	for {
 		select {
-		case <-ticker.C:
-			result, done, err := check(ctx)
-			if err != nil {
-				return result, err
+		case <-timer.C:
+		case <-ctx.Done():
+			var zero T
+			return zero, timeoutErr(ctx.Err())
+		}
+
+		attempt++
+
+		value, err := get(ctx)
+
+		switch {
+		case err == nil:
+		case opts.RetryOn != nil && opts.RetryOn(err):
+			interval = opts.backoff(interval)
+			timer.Reset(opts.jitter(interval))
+
+			continue
+		default:
+			return value, err
+		}
+
+		if opts.OnStateChange != nil {
+			state := ""
+			if opts.State != nil {
+				state = opts.State(value)
 			}
 
-			if done {
-				return result, nil
+			if attempt == 1 || opts.State == nil || state != lastState {
+				opts.OnStateChange(WaitProgress[T]{
+					Attempt: attempt,
+					Elapsed: time.Since(start),
+					State:   state,
+					Value:   value,
+				})
 			}
-		case <-ctx.Done():
-			var zero T
-			return zero, timeoutErr()
+
+			lastState = state
+		}
+
+		if condition == nil {
+			return value, nil
+		}
+
+		done, err := condition(value)
+		if err != nil {
+			return value, err
+		}
+
+		if done {
+			return value, nil
 		}
+
+		interval = opts.backoff(interval)
+		timer.Reset(opts.jitter(interval))
 	}
 }
 
-func newTicker(client *Client) *time.Ticker {
-	return time.NewTicker(client.GetPollDelay())
+// backoff returns the interval to use after the given interval.
+func (o *WaitOptions[T]) backoff(interval time.Duration) time.Duration {
+	if o.BackoffFactor <= 1 {
+		return interval
+	}
+
+	interval = time.Duration(float64(interval) * o.BackoffFactor)
+	if o.MaxInterval > 0 {
+		interval = min(interval, o.MaxInterval)
+	}
+
+	return interval
+}
+
+// jitter randomizes the given interval by up to the configured jitter fraction.
+func (o *WaitOptions[T]) jitter(interval time.Duration) time.Duration {
+	if o.Jitter <= 0 {
+		return interval
+	}
+
+	//nolint:gosec // jitter does not need a cryptographically secure random number
+	return time.Duration(float64(interval) * (1 + o.Jitter*(2*rand.Float64()-1)))
 }
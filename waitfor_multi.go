@@ -0,0 +1,273 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MultiWaitResult is the outcome of waiting for a single resource using WaitForMany.
+type MultiWaitResult[T any] struct {
+	// ID is the ID of the resource.
+	ID int
+
+	// Value is the last observed value of the resource, or nil if it was never observed.
+	Value *T
+
+	// Err is nil if the resource reached the desired state. Otherwise, it describes
+	// why the resource failed, e.g. a *ResourceGoneError if the resource no longer exists.
+	Err error
+}
+
+// MultiWaitOptions configures a call to WaitForMany.
+type MultiWaitOptions[T any] struct {
+	// Interval is the initial duration between polls.
+	// Defaults to the client's poll delay.
+	Interval time.Duration
+
+	// BackoffFactor multiplies the interval after each poll.
+	// A value <= 1 polls at a fixed interval.
+	BackoffFactor float64
+
+	// MaxInterval limits the interval when backing off.
+	MaxInterval time.Duration
+
+	// Jitter randomizes each interval by up to the given fraction, e.g. 0.1 for +/- 10%.
+	Jitter float64
+
+	// Timeout limits the total duration of the wait in addition to any context deadline.
+	Timeout time.Duration
+
+	// OnSettled is called with the outcome of each resource as soon as it settles.
+	OnSettled func(result MultiWaitResult[T])
+
+	// RetryOn reports whether polling should continue after the given error
+	// was returned when listing the remaining resources.
+	// By default, all list errors are logged and retried until the wait is canceled.
+	RetryOn func(err error) bool
+}
+
+// MultiWaitError is returned when one or more resources waited on by WaitForMany
+// failed or did not reach the desired state in time.
+type MultiWaitError struct {
+	// Errors maps the IDs of failed resources to their errors.
+	Errors map[int]error
+}
+
+func (e *MultiWaitError) Error() string {
+	ids := make([]int, 0, len(e.Errors))
+	for id := range e.Errors {
+		ids = append(ids, id)
+	}
+
+	sort.Ints(ids)
+
+	messages := make([]string, len(ids))
+	for i, id := range ids {
+		messages[i] = fmt.Sprintf("%d: %s", id, e.Errors[id])
+	}
+
+	return fmt.Sprintf("failed to wait for %d resource(s): %s", len(ids), strings.Join(messages, "; "))
+}
+
+// Unwrap returns the errors of all failed resources.
+func (e *MultiWaitError) Unwrap() []error {
+	result := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		result = append(result, err)
+	}
+
+	return result
+}
+
+// WaitForInstancesStatus waits for all the given Linode instances to reach the desired state.
+// Instances are polled using a filtered list request per 100 instances per poll.
+func (client Client) WaitForInstancesStatus(
+	ctx context.Context,
+	instanceIDs []int,
+	status InstanceStatus,
+) ([]MultiWaitResult[Instance], error) {
+	return WaitForMany(ctx, &client, instanceIDs, client.ListInstances,
+		func(instance Instance) int { return instance.ID },
+		func(instance Instance) (bool, error) {
+			return instance.Status == status, nil
+		},
+		nil,
+	)
+}
+
+// WaitForVolumesStatus waits for all the given Volumes to reach the desired state.
+// Volumes are polled using a filtered list request per 100 volumes per poll.
+func (client Client) WaitForVolumesStatus(
+	ctx context.Context,
+	volumeIDs []int,
+	status VolumeStatus,
+) ([]MultiWaitResult[Volume], error) {
+	return WaitForMany(ctx, &client, volumeIDs, client.ListVolumes,
+		func(volume Volume) int { return volume.ID },
+		func(volume Volume) (bool, error) {
+			return volume.Status == status, nil
+		},
+		nil,
+	)
+}
+
+// WaitForDatabasesStatus waits for all the given databases to reach the desired state,
+// regardless of their engine.
+// Databases are polled using a filtered list request per 100 databases per poll.
+func (client Client) WaitForDatabasesStatus(
+	ctx context.Context,
+	databaseIDs []int,
+	status DatabaseStatus,
+) ([]MultiWaitResult[Database], error) {
+	return WaitForMany(ctx, &client, databaseIDs, client.ListDatabases,
+		func(db Database) int { return db.ID },
+		func(db Database) (bool, error) {
+			return db.Status == status, nil
+		},
+		nil,
+	)
+}
+
+// WaitForMany waits for all the resources with the given IDs to satisfy the given condition.
+// Rather than polling each resource individually, the remaining resources are polled
+// using list requests filtered using an "+or" over their IDs, each covering up to
+// DefaultBatchLoaderMaxBatchSize resources.
+//
+// The outcome of each resource is returned in the order of the given IDs.
+// Resources missing from the list response settle with a *ResourceGoneError, and
+// resources for which the condition returns an error settle with that error.
+// Failed list requests are retried unless MultiWaitOptions.RetryOn reports otherwise.
+// If any resource fails, a *MultiWaitError describing each failure is also returned.
+func WaitForMany[T any](
+	ctx context.Context,
+	client *Client,
+	ids []int,
+	list func(ctx context.Context, opts *ListOptions) ([]T, error),
+	idFunc func(T) int,
+	condition WaitCondition[T],
+	opts *MultiWaitOptions[T],
+) ([]MultiWaitResult[T], error) {
+	if opts == nil {
+		opts = &MultiWaitOptions[T]{}
+	}
+
+	if len(ids) == 0 {
+		return []MultiWaitResult[T]{}, nil
+	}
+
+	results := make(map[int]*MultiWaitResult[T], len(ids))
+	remaining := make([]int, 0, len(ids))
+
+	for _, id := range ids {
+		if _, ok := results[id]; ok {
+			continue
+		}
+
+		results[id] = &MultiWaitResult[T]{ID: id}
+		remaining = append(remaining, id)
+	}
+
+	settle := func(result *MultiWaitResult[T], err error) {
+		result.Err = err
+
+		if opts.OnSettled != nil {
+			opts.OnSettled(*result)
+		}
+	}
+
+	waitOptions := &WaitOptions[int]{
+		Interval:      opts.Interval,
+		BackoffFactor: opts.BackoffFactor,
+		MaxInterval:   opts.MaxInterval,
+		Jitter:        opts.Jitter,
+		Timeout:       opts.Timeout,
+		RetryOn:       opts.RetryOn,
+	}
+
+	if waitOptions.RetryOn == nil {
+		waitOptions.RetryOn = func(err error) bool {
+			log.Printf("[WARN] Retrying failed list request while waiting for %d resource(s): %s", len(remaining), err)
+			return true
+		}
+	}
+
+	_, err := waitFor(ctx, client,
+		func(ctx context.Context) (int, error) {
+			entries, err := listByIDsChunked(ctx, list, idFunc, remaining)
+			if err != nil {
+				return len(remaining), err
+			}
+
+			pending := remaining[:0]
+
+			for _, id := range remaining {
+				result := results[id]
+
+				entry, found := entries[id]
+				if !found {
+					settle(result, &ResourceGoneError{Err: newIDNotFoundError(id)})
+					continue
+				}
+
+				result.Value = &entry
+
+				done, conditionErr := condition(entry)
+
+				switch {
+				case conditionErr != nil:
+					settle(result, conditionErr)
+				case done:
+					settle(result, nil)
+				default:
+					pending = append(pending, id)
+				}
+			}
+
+			remaining = pending
+
+			return len(remaining), nil
+		},
+		func(pending int) (bool, error) {
+			return pending == 0, nil
+		},
+		waitOptions,
+		func(err error) error {
+			return err
+		},
+	)
+	if err != nil {
+		// Any resources that have not yet settled fail with the error that ended the wait
+		for _, id := range remaining {
+			settle(results[id], fmt.Errorf("failed to wait for resource %d: %w", id, err))
+		}
+	}
+
+	output := make([]MultiWaitResult[T], 0, len(results))
+	failures := make(map[int]error)
+
+	for _, id := range ids {
+		result, ok := results[id]
+		if !ok {
+			// Skip duplicate IDs
+			continue
+		}
+
+		delete(results, id)
+
+		if result.Err != nil {
+			failures[id] = result.Err
+		}
+
+		output = append(output, *result)
+	}
+
+	if len(failures) > 0 {
+		return output, &MultiWaitError{Errors: failures}
+	}
+
+	return output, nil
+}